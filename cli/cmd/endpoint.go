@@ -22,6 +22,8 @@ var endpointCmd = &cobra.Command{
 
 var endpointName string
 var endpointLambdaID string
+var endpointPath string
+var endpointAlias string
 
 type endpointOps struct {
 	ctx context.Context
@@ -57,6 +59,31 @@ func (op *endpointOps) List() tea.Cmd {
 	}
 }
 
+func (op *endpointOps) Update(id string, req *api.UpdateEndpoint) tea.Cmd {
+	return func() tea.Msg {
+		endpt, err := ops.UpdateEndpoint(op.ctx, id, req)
+
+		return endpoint.EndpointUpdateResponseMsg{
+			Resp: &endpoint.EndpointUpdateResponse{
+				Endpoint: endpt,
+				Err:      err,
+			},
+		}
+	}
+}
+
+func (op *endpointOps) Delete(id string) tea.Cmd {
+	return func() tea.Msg {
+		err := ops.DeleteEndpoint(op.ctx, id)
+
+		return endpoint.EndpointDeleteResponseMsg{
+			Resp: &endpoint.EndpointDeleteResponse{
+				Err: err,
+			},
+		}
+	}
+}
+
 var endpointCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create",
@@ -108,11 +135,72 @@ var endpointListCmd = &cobra.Command{
 	},
 }
 
+var endpointUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		req := &api.UpdateEndpoint{}
+		if cmd.Flags().Changed("name") {
+			req.Name = &endpointName
+		}
+
+		if cmd.Flags().Changed("path") {
+			req.Path = &endpointPath
+		}
+
+		if cmd.Flags().Changed("lambda-id") {
+			req.Lambda = &endpointLambdaID
+		}
+
+		if cmd.Flags().Changed("alias") {
+			req.Alias = &endpointAlias
+		}
+
+		m := &endpoint.EndpointUpdateModel{
+			EndpointID: args[0],
+			Req:        req,
+			Updater:    &endpointOps{ctx: cmd.Context()},
+		}
+		p := tea.NewProgram(endpoint.InitEndpointUpdateModel(m))
+
+		if err := p.Start(); err != nil {
+			fmt.Printf("Error: %s", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var endpointDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		m := &endpoint.EndpointDeleteModel{
+			EndpointID: args[0],
+			Deleter:    &endpointOps{ctx: cmd.Context()},
+		}
+		p := tea.NewProgram(endpoint.InitEndpointDeleteModel(m))
+
+		if err := p.Start(); err != nil {
+			fmt.Printf("Error: %s", err)
+			os.Exit(1)
+		}
+	},
+}
+
 func init() {
 	RootCmd.AddCommand(endpointCmd)
 	endpointCmd.AddCommand(endpointCreateCmd)
 	endpointCmd.AddCommand(endpointListCmd)
+	endpointCmd.AddCommand(endpointUpdateCmd)
+	endpointCmd.AddCommand(endpointDeleteCmd)
 
 	endpointCreateCmd.Flags().StringVarP(&endpointName, "name", "n", "", "endpoint name")
 	endpointCreateCmd.Flags().StringVarP(&endpointLambdaID, "lambda-id", "l", "", "lambda id")
+
+	endpointUpdateCmd.Flags().StringVarP(&endpointName, "name", "n", "", "endpoint name")
+	endpointUpdateCmd.Flags().StringVarP(&endpointPath, "path", "p", "", "endpoint path")
+	endpointUpdateCmd.Flags().StringVarP(&endpointLambdaID, "lambda-id", "l", "", "lambda id")
+	endpointUpdateCmd.Flags().StringVarP(&endpointAlias, "alias", "a", "", "lambda alias, empty to remove")
 }
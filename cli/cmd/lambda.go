@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/hedlx/doless/cli/ops"
@@ -23,9 +24,17 @@ var lambdaCmd = &cobra.Command{
 var lambdaName string
 var lambdaRuntime string
 var lambdaType string
+var lambdaID string
+var lambdaCascade bool
+var lambdaReplicas int64
+var lambdaLogsSince string
+var lambdaLogsTail int64
+var lambdaLogsFollow bool
+var lambdaBuildArgs []string
 
 type lambdaOps struct {
-	ctx context.Context
+	ctx       context.Context
+	buildArgs []api.LambdaBuildArg
 }
 
 func (op *lambdaOps) Create(name string, runtime string, lambdaType string, path string) tea.Cmd {
@@ -34,6 +43,7 @@ func (op *lambdaOps) Create(name string, runtime string, lambdaType string, path
 			Name:       name,
 			Runtime:    runtime,
 			LambdaType: lambdaType,
+			BuildArgs:  op.buildArgs,
 		}, path)
 
 		return lambda.LambdaCreateResponseMsg{
@@ -58,9 +68,10 @@ func (op *lambdaOps) List() tea.Cmd {
 	}
 }
 
-func (op *lambdaOps) Start(id string) tea.Cmd {
+func (op *lambdaOps) Start(id string, progress chan<- string) tea.Cmd {
 	return func() tea.Msg {
-		l, err := ops.StartLambda(op.ctx, id)
+		l, err := ops.StartLambda(op.ctx, id, func(line string) { progress <- line })
+		close(progress)
 
 		return lambda.LambdaStartResponseMsg{
 			Resp: &lambda.LambdaStartResponse{
@@ -71,6 +82,45 @@ func (op *lambdaOps) Start(id string) tea.Cmd {
 	}
 }
 
+func (op *lambdaOps) Stop(id string) tea.Cmd {
+	return func() tea.Msg {
+		l, err := ops.StopLambda(op.ctx, id)
+
+		return lambda.LambdaStopResponseMsg{
+			Resp: &lambda.LambdaStopResponse{
+				Lambda: l,
+				Err:    err,
+			},
+		}
+	}
+}
+
+func (op *lambdaOps) Scale(id string, replicas int64) tea.Cmd {
+	return func() tea.Msg {
+		l, err := ops.ScaleLambda(op.ctx, id, replicas)
+
+		return lambda.LambdaScaleResponseMsg{
+			Resp: &lambda.LambdaScaleResponse{
+				Lambda: l,
+				Err:    err,
+			},
+		}
+	}
+}
+
+func (op *lambdaOps) Update(id string, path string) tea.Cmd {
+	return func() tea.Msg {
+		l, err := ops.UpdateLambdaCode(op.ctx, id, path)
+
+		return lambda.LambdaUpdateResponseMsg{
+			Resp: &lambda.LambdaUpdateResponse{
+				Lambda: l,
+				Err:    err,
+			},
+		}
+	}
+}
+
 func (op *lambdaOps) Destroy(id string) tea.Cmd {
 	return func() tea.Msg {
 		err := ops.DestroyLambda(op.ctx, id)
@@ -83,6 +133,18 @@ func (op *lambdaOps) Destroy(id string) tea.Cmd {
 	}
 }
 
+func (op *lambdaOps) Delete(id string, cascade bool) tea.Cmd {
+	return func() tea.Msg {
+		err := ops.DeleteLambda(op.ctx, id, cascade)
+
+		return lambda.LambdaDeleteResponseMsg{
+			Resp: &lambda.LambdaDeleteResponse{
+				Err: err,
+			},
+		}
+	}
+}
+
 func lambdaCreateProgram(cmd *cobra.Command, args []string) *tea.Program {
 	var runtime *api.Runtime
 	if lambdaRuntime != "" {
@@ -94,12 +156,23 @@ func lambdaCreateProgram(cmd *cobra.Command, args []string) *tea.Program {
 		}
 	}
 
+	buildArgs := []api.LambdaBuildArg{}
+	for _, arg := range lambdaBuildArgs {
+		name, value, found := strings.Cut(arg, "=")
+		if !found {
+			fmt.Printf("Build arg must be in name=value form: %s", arg)
+			os.Exit(1)
+		}
+
+		buildArgs = append(buildArgs, api.LambdaBuildArg{Name: name, Value: value})
+	}
+
 	m := &lambda.LambdaCreateModel{
 		Name:          lambdaName,
 		Runtime:       runtime,
 		LambdaType:    lambdaType,
 		Path:          args[0],
-		LambdaCreator: &lambdaOps{ctx: cmd.Context()},
+		LambdaCreator: &lambdaOps{ctx: cmd.Context(), buildArgs: buildArgs},
 		RuntimeLister: &runtimeOps{ctx: cmd.Context()},
 	}
 
@@ -188,6 +261,88 @@ var lambdaDeployCmd = &cobra.Command{
 	},
 }
 
+var lambdaStopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop lambda",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		m := &lambda.LambdaStopModel{
+			LambdaID: args[0],
+			Stopper:  &lambdaOps{ctx: cmd.Context()},
+		}
+
+		p := tea.NewProgram(lambda.InitLambdaStopModel(m))
+		if err := p.Start(); err != nil {
+			fmt.Printf("Error: %s", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var lambdaScaleCmd = &cobra.Command{
+	Use:   "scale",
+	Short: "Change number of lambda containers",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		m := &lambda.LambdaScaleModel{
+			LambdaID: args[0],
+			Replicas: lambdaReplicas,
+			Scaler:   &lambdaOps{ctx: cmd.Context()},
+		}
+
+		p := tea.NewProgram(lambda.InitLambdaScaleModel(m))
+		if err := p.Start(); err != nil {
+			fmt.Printf("Error: %s", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var lambdaLogsCmd = &cobra.Command{
+	Use:   "logs",
+	Short: "Print lambda logs",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		opts := ops.LambdaLogsM{
+			Since:  lambdaLogsSince,
+			Tail:   lambdaLogsTail,
+			Follow: lambdaLogsFollow,
+		}
+
+		err := ops.LambdaLogs(cmd.Context(), args[0], opts, func(line api.LambdaLogLine) {
+			out := os.Stdout
+			if line.Stream == "stderr" {
+				out = os.Stderr
+			}
+
+			fmt.Fprintf(out, "[%d] %s\n", line.Replica, line.Text)
+		})
+		if err != nil {
+			fmt.Printf("Error: %s", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var lambdaUpdateCmd = &cobra.Command{
+	Use:   "update [path]",
+	Short: "Update lambda code",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		m := &lambda.LambdaUpdateModel{
+			LambdaID: lambdaID,
+			Path:     args[0],
+			Updater:  &lambdaOps{ctx: cmd.Context()},
+		}
+
+		p := tea.NewProgram(lambda.InitLambdaUpdateModel(m))
+		if err := p.Start(); err != nil {
+			fmt.Printf("Error: %s", err)
+			os.Exit(1)
+		}
+	},
+}
+
 var lambdaDestroyCmd = &cobra.Command{
 	Use:   "destroy",
 	Short: "Destroy lambda",
@@ -206,19 +361,57 @@ var lambdaDestroyCmd = &cobra.Command{
 	},
 }
 
+var lambdaDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete lambda with its code and metadata",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		m := &lambda.LambdaDeleteModel{
+			LambdaID: args[0],
+			Cascade:  lambdaCascade,
+			Deleter:  &lambdaOps{ctx: cmd.Context()},
+		}
+
+		p := tea.NewProgram(lambda.InitLambdaDeleteModel(m))
+		if err := p.Start(); err != nil {
+			fmt.Printf("Error: %s", err)
+			os.Exit(1)
+		}
+	},
+}
+
 func init() {
 	RootCmd.AddCommand(lambdaCmd)
 	lambdaCmd.AddCommand(lambdaDeployCmd)
 	lambdaCmd.AddCommand(lambdaCreateCmd)
 	lambdaCmd.AddCommand(lambdaListCmd)
 	lambdaCmd.AddCommand(lambdaStartCmd)
+	lambdaCmd.AddCommand(lambdaStopCmd)
+	lambdaCmd.AddCommand(lambdaScaleCmd)
+	lambdaCmd.AddCommand(lambdaLogsCmd)
+	lambdaCmd.AddCommand(lambdaUpdateCmd)
 	lambdaCmd.AddCommand(lambdaDestroyCmd)
+	lambdaCmd.AddCommand(lambdaDeleteCmd)
 
 	lambdaCreateCmd.Flags().StringVarP(&lambdaName, "name", "n", "", "name")
 	lambdaCreateCmd.Flags().StringVarP(&lambdaRuntime, "runtime", "r", "", "runtime")
 	lambdaCreateCmd.Flags().StringVarP(&lambdaType, "type", "t", "", "type of lambda (ENDPOINT | INTERNAL)")
+	lambdaCreateCmd.Flags().StringArrayVarP(&lambdaBuildArgs, "build-arg", "b", nil, "runtime parameter value in name=value form")
 
 	lambdaDeployCmd.Flags().StringVarP(&lambdaName, "name", "n", "", "name")
 	lambdaDeployCmd.Flags().StringVarP(&lambdaRuntime, "runtime", "r", "", "runtime")
 	lambdaDeployCmd.Flags().StringVarP(&lambdaType, "type", "e", "", "type of lambda (ENDPOINT | INTERNAL)")
+	lambdaDeployCmd.Flags().StringArrayVarP(&lambdaBuildArgs, "build-arg", "b", nil, "runtime parameter value in name=value form")
+
+	lambdaUpdateCmd.Flags().StringVarP(&lambdaID, "lambda-id", "l", "", "lambda id")
+	lambdaUpdateCmd.MarkFlagRequired("lambda-id")
+
+	lambdaScaleCmd.Flags().Int64VarP(&lambdaReplicas, "replicas", "r", 1, "number of containers")
+	lambdaScaleCmd.MarkFlagRequired("replicas")
+
+	lambdaLogsCmd.Flags().StringVarP(&lambdaLogsSince, "since", "s", "", "unix timestamp or relative duration like 10m")
+	lambdaLogsCmd.Flags().Int64VarP(&lambdaLogsTail, "tail", "t", -1, "number of the last lines of every replica, all by default")
+	lambdaLogsCmd.Flags().BoolVarP(&lambdaLogsFollow, "follow", "f", false, "follow new lines")
+
+	lambdaDeleteCmd.Flags().BoolVarP(&lambdaCascade, "cascade", "c", false, "delete endpoints of the lambda too")
 }
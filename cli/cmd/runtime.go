@@ -3,10 +3,13 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/hedlx/doless/cli/ops"
 	"github.com/hedlx/doless/cli/tui/runtime"
+	api "github.com/hedlx/doless/client"
 	"github.com/spf13/cobra"
 )
 
@@ -19,14 +22,20 @@ var runtimeCmd = &cobra.Command{
 }
 
 var runtimeName string
+var runtimeBase string
+var runtimeParams []string
+var runtimeBatch int64
 
 type runtimeOps struct {
 	ctx context.Context
+	// base is the path of the base image Dockerfile
+	base   string
+	params []api.RuntimeParameter
 }
 
 func (op *runtimeOps) Create(name string, path string) tea.Cmd {
 	return func() tea.Msg {
-		rt, err := ops.CreateRuntime(op.ctx, name, path)
+		rt, err := ops.CreateRuntime(op.ctx, name, path, op.base, op.params)
 
 		return runtime.RuntimeCreateResponseMsg{
 			Resp: &runtime.RuntimeCreateResponse{
@@ -55,11 +64,19 @@ var runtimeCreateCmd = &cobra.Command{
 	Short: "Create",
 	Args:  cobra.MinimumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
+		params, err := parseRuntimeParams(runtimeParams)
+		if err != nil {
+			fmt.Printf("Error: %s\n", err)
+			os.Exit(1)
+		}
+
 		m := &runtime.RuntimeCreateModel{
 			Name: runtimeName,
 			Path: args[0],
 			Creator: &runtimeOps{
-				ctx: cmd.Context(),
+				ctx:    cmd.Context(),
+				base:   runtimeBase,
+				params: params,
 			},
 		}
 		p := tea.NewProgram(runtime.InitRuntimeCreateModel(m))
@@ -87,10 +104,52 @@ var runtimeListCmd = &cobra.Command{
 	},
 }
 
+var runtimeRolloutCmd = &cobra.Command{
+	Use:   "rollout [id]",
+	Short: "Rebuild lambdas built with older runtime revisions",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		err := ops.RolloutRuntime(cmd.Context(), args[0], runtimeBatch, func(line string) {
+			fmt.Println(line)
+		})
+		if err != nil {
+			fmt.Printf("Error: %s\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+// parseRuntimeParams parses parameters in "name:kind[=default]" form.
+func parseRuntimeParams(raw []string) ([]api.RuntimeParameter, error) {
+	params := []api.RuntimeParameter{}
+
+	for _, r := range raw {
+		decl, def, hasDefault := strings.Cut(r, "=")
+		name, kind, found := strings.Cut(decl, ":")
+		if !found || name == "" || kind == "" {
+			return nil, fmt.Errorf("parameter must be in name:kind[=default] form: %s", r)
+		}
+
+		param := api.RuntimeParameter{Name: name, Kind: kind}
+		if hasDefault {
+			param.Default = &def
+		}
+
+		params = append(params, param)
+	}
+
+	return params, nil
+}
+
 func init() {
 	RootCmd.AddCommand(runtimeCmd)
 	runtimeCmd.AddCommand(runtimeCreateCmd)
 	runtimeCmd.AddCommand(runtimeListCmd)
+	runtimeCmd.AddCommand(runtimeRolloutCmd)
 
 	runtimeCreateCmd.Flags().StringVarP(&runtimeName, "name", "n", "", "name")
+	runtimeCreateCmd.Flags().StringVarP(&runtimeBase, "base", "b", "", "base image Dockerfile, lambda builds derive from it")
+	runtimeCreateCmd.Flags().StringArrayVarP(&runtimeParams, "param", "p", nil, "parameter of the Dockerfile in name:kind[=default] form, kind is string, integer or boolean")
+
+	runtimeRolloutCmd.Flags().Int64VarP(&runtimeBatch, "batch", "b", 1, "number of lambdas rebuilt at once")
 }
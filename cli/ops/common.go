@@ -1,14 +1,13 @@
 package ops
 
 import (
-	"archive/tar"
-	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
-	"time"
 
 	api "github.com/hedlx/doless/client"
 )
@@ -26,25 +25,12 @@ func init() {
 	client = api.NewAPIClient(config)
 }
 
-func upload(ctx context.Context, path string, isDir bool) (string, error) {
-	if isDir {
-		var err error
-		path, err = tarPath(path)
-		if err != nil {
-			return "", err
-		}
-	}
-
+func upload(ctx context.Context, path string) (string, error) {
 	file, err := os.Open(path)
 	if err != nil {
 		return "", err
 	}
 	defer file.Close()
-	defer func() {
-		if isDir {
-			os.Remove(path)
-		}
-	}()
 
 	uploadResp, _, err := client.UploadApi.Upload(ctx).File(file).Execute()
 	if err != nil {
@@ -54,71 +40,125 @@ func upload(ctx context.Context, path string, isDir bool) (string, error) {
 	return uploadResp.GetId(), nil
 }
 
-func pollTask(ctx context.Context, id string) (*api.TaskStatus, error) {
-	for {
-		resp, _, err := client.TaskApi.
-			GetTask(ctx, id).
-			Execute()
-		if err != nil {
-			return nil, fmt.Errorf("error when calling `TaskApi.GetTask``: %v", err)
-		}
-
-		if resp.GetStatus() != "PENDING" {
-			return resp, nil
-		}
-
-		time.Sleep(time.Second)
+// uploadSources uploads files of the directory as blobs, skipping the ones the manager already has,
+// and returns the manifest of the lambda sources.
+func uploadSources(ctx context.Context, dir string) ([]api.LambdaManifestFile, error) {
+	stat, err := os.Stat(dir)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("path does not exist: %s", dir)
+	}
+	if err != nil {
+		return nil, err
 	}
-}
 
-func tarPath(src string) (string, error) {
-	if _, err := os.Stat(src); os.IsNotExist(err) {
-		return "", fmt.Errorf("path does not exist: %s", src)
+	// Single file source is placed at the lambda root under its own name
+	root := dir
+	if !stat.IsDir() {
+		root = filepath.Dir(dir)
 	}
 
-	var buffer bytes.Buffer
-	writer := tar.NewWriter(&buffer)
-	pathLen := len(src)
+	manifest := []api.LambdaManifestFile{}
+	files := map[string]string{}
 
-	err := filepath.Walk(src, func(file string, info os.FileInfo, lerr error) error {
-		header, err := tar.FileInfoHeader(info, file)
-		if err != nil {
-			return err
+	err = filepath.Walk(dir, func(file string, info os.FileInfo, lerr error) error {
+		if lerr != nil {
+			return lerr
 		}
 
-		header.Name = filepath.ToSlash(file)[pathLen:]
+		// Symlinks are followed, so the manager gets content of the files they point to
+		if stat, err := os.Stat(file); err != nil || !stat.Mode().IsRegular() {
+			return err
+		}
 
-		if err := writer.WriteHeader(header); err != nil {
+		digest, err := fileDigest(file)
+		if err != nil {
 			return err
 		}
 
-		if !info.IsDir() {
-			data, err := os.Open(file)
-			if err != nil {
-				return err
-			}
-			if _, err := io.Copy(writer, data); err != nil {
-				return err
-			}
+		rel, err := filepath.Rel(root, file)
+		if err != nil {
+			return err
 		}
 
+		manifest = append(manifest, api.LambdaManifestFile{Path: filepath.ToSlash(rel), Digest: digest})
+		files[digest] = file
+
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
 
+	digests := []string{}
+	for digest := range files {
+		digests = append(digests, digest)
+	}
+
+	missing, _, err := client.BlobApi.
+		FindMissingBlobs(ctx).
+		BlobDigests(api.BlobDigests{Digests: digests}).
+		Execute()
 	if err != nil {
-		return "", err
+		return nil, fmt.Errorf("error when calling `BlobApi.FindMissingBlobs``: %v", err)
 	}
 
-	file, err := os.CreateTemp("", "lambda-")
+	for _, digest := range missing.Digests {
+		if err := uploadBlob(ctx, digest, files[digest]); err != nil {
+			return nil, err
+		}
+	}
+
+	return manifest, nil
+}
+
+func uploadBlob(ctx context.Context, digest string, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if _, err := client.BlobApi.UploadBlob(ctx, digest).File(file).Execute(); err != nil {
+		return fmt.Errorf("error when calling `BlobApi.UploadBlob``: %v", err)
+	}
+
+	return nil
+}
+
+// fileDigest returns hex encoded sha256 of the file content, it names the blob of the file.
+func fileDigest(path string) (string, error) {
+	file, err := os.Open(path)
 	if err != nil {
 		return "", err
 	}
 	defer file.Close()
 
-	if _, err := io.Copy(file, &buffer); err != nil {
-		os.Remove(file.Name())
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
 		return "", err
 	}
 
-	return file.Name(), nil
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func watchTask(ctx context.Context, id string) (*api.TaskStatus, error) {
+	return watchTaskProgress(ctx, id, func(string) {})
+}
+
+// watchTaskProgress waits for the task passing its progress lines to progress as they come.
+func watchTaskProgress(ctx context.Context, id string, progress func(line string)) (*api.TaskStatus, error) {
+	status, err := client.WatchTask(ctx, id, func(status *api.TaskStatus) {
+		for _, line := range status.Progress {
+			progress(line)
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error when watching task: %w", err)
+	}
+
+	if status.GetStatus() == api.TaskCancelled {
+		return nil, fmt.Errorf("task is cancelled: %s", id)
+	}
+
+	return status, nil
 }
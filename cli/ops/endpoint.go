@@ -29,3 +29,26 @@ func ListEndpoints(ctx context.Context) ([]api.Endpoint, error) {
 
 	return listResp, nil
 }
+
+func UpdateEndpoint(ctx context.Context, id string, req *api.UpdateEndpoint) (*api.Endpoint, error) {
+	updateResp, _, err := client.EndpointApi.
+		UpdateEndpoint(ctx, id).
+		UpdateEndpoint(*req).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("error when calling `EndpointApi.UpdateEndpoint``: %v", err)
+	}
+
+	return updateResp, nil
+}
+
+func DeleteEndpoint(ctx context.Context, id string) error {
+	_, err := client.EndpointApi.
+		DeleteEndpoint(ctx, id).
+		Execute()
+	if err != nil {
+		return fmt.Errorf("error when calling `EndpointApi.DeleteEndpoint``: %v", err)
+	}
+
+	return nil
+}
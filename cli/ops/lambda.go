@@ -12,10 +12,11 @@ type CreateLambdaM struct {
 	Name       string
 	Runtime    string
 	LambdaType string
+	BuildArgs  []api.LambdaBuildArg
 }
 
 func CreateLambda(ctx context.Context, lambda CreateLambdaM, path string) (*api.Lambda, error) {
-	uploadID, err := upload(ctx, path, true)
+	manifest, err := uploadSources(ctx, path)
 	if err != nil {
 		return nil, err
 	}
@@ -26,7 +27,8 @@ func CreateLambda(ctx context.Context, lambda CreateLambdaM, path string) (*api.
 			Name:       lambda.Name,
 			Runtime:    lambda.Runtime,
 			LambdaType: lambda.LambdaType,
-			Archive:    uploadID,
+			Manifest:   manifest,
+			BuildArgs:  lambda.BuildArgs,
 		}).
 		Execute()
 	if err != nil {
@@ -60,7 +62,8 @@ func ListLambdas(ctx context.Context) ([]api.Lambda, error) {
 	return resp, nil
 }
 
-func StartLambda(ctx context.Context, id string) (*api.Lambda, error) {
+// StartLambda starts lambda passing its image build output to progress.
+func StartLambda(ctx context.Context, id string, progress func(line string)) (*api.Lambda, error) {
 	taskResp, _, err := client.LambdaApi.
 		StartLambda(ctx, id).
 		Execute()
@@ -68,18 +71,89 @@ func StartLambda(ctx context.Context, id string) (*api.Lambda, error) {
 		return nil, fmt.Errorf("error when calling `LambdaApi.StartLambda``: %v", err)
 	}
 
-	res, err := pollTask(ctx, taskResp.GetTask())
+	res, err := watchTaskProgress(ctx, taskResp.GetTask(), progress)
 	if err != nil {
 		return nil, err
 	}
 
-	if res.GetStatus() == "FAILED" {
+	if res.GetStatus() == api.TaskFailed {
 		return nil, fmt.Errorf("error when starting lambda: %v", res.GetDetails()["error"])
 	}
 
 	return GetLambda(ctx, id)
 }
 
+func StopLambda(ctx context.Context, id string) (*api.Lambda, error) {
+	taskResp, _, err := client.LambdaApi.
+		StopLambda(ctx, id).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("error when calling `LambdaApi.StopLambda``: %v", err)
+	}
+
+	res, err := watchTask(ctx, taskResp.GetTask())
+	if err != nil {
+		return nil, err
+	}
+
+	if res.GetStatus() == api.TaskFailed {
+		return nil, fmt.Errorf("error when stopping lambda: %v", res.GetDetails()["error"])
+	}
+
+	return GetLambda(ctx, id)
+}
+
+func ScaleLambda(ctx context.Context, id string, replicas int64) (*api.Lambda, error) {
+	taskResp, r, err := client.LambdaApi.
+		ScaleLambda(ctx, id).
+		ScaleLambda(api.ScaleLambda{Replicas: replicas}).
+		Execute()
+	if err != nil {
+		var details api.Error
+		json.NewDecoder(r.Body).Decode(&details)
+		return nil, fmt.Errorf("error when calling `LambdaApi.ScaleLambda``: %v\n%v", err, details.GetError())
+	}
+
+	res, err := watchTask(ctx, taskResp.GetTask())
+	if err != nil {
+		return nil, err
+	}
+
+	if res.GetStatus() == api.TaskFailed {
+		return nil, fmt.Errorf("error when scaling lambda: %v", res.GetDetails()["error"])
+	}
+
+	return GetLambda(ctx, id)
+}
+
+func UpdateLambdaCode(ctx context.Context, id string, path string) (*api.Lambda, error) {
+	manifest, err := uploadSources(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	taskResp, r, err := client.LambdaApi.
+		UpdateLambdaCode(ctx, id).
+		UpdateLambdaCode(api.UpdateLambdaCode{Manifest: manifest}).
+		Execute()
+	if err != nil {
+		var details api.Error
+		json.NewDecoder(r.Body).Decode(&details)
+		return nil, fmt.Errorf("error when calling `LambdaApi.UpdateLambdaCode``: %v\n%v", err, details.GetError())
+	}
+
+	res, err := watchTask(ctx, taskResp.GetTask())
+	if err != nil {
+		return nil, err
+	}
+
+	if res.GetStatus() == api.TaskFailed {
+		return nil, fmt.Errorf("error when updating lambda: %v", res.GetDetails()["error"])
+	}
+
+	return GetLambda(ctx, id)
+}
+
 func DestroyLambda(ctx context.Context, id string) error {
 	taskResp, _, err := client.LambdaApi.
 		DestroyLambda(ctx, id).
@@ -88,23 +162,45 @@ func DestroyLambda(ctx context.Context, id string) error {
 		return fmt.Errorf("error when calling `LambdaApi.DestroyLambda``: %v", err)
 	}
 
-	res, err := pollTask(ctx, taskResp.GetTask())
+	res, err := watchTask(ctx, taskResp.GetTask())
 	if err != nil {
 		return err
 	}
 
-	if res.GetStatus() == "FAILED" {
+	if res.GetStatus() == api.TaskFailed {
 		return fmt.Errorf("error when destroying lambda: %v", res.GetDetails()["error"])
 	}
 
 	return nil
 }
 
-func DeployLambda(ctx context.Context, input CreateLambdaM, path string) (*api.Lambda, error) {
+func DeleteLambda(ctx context.Context, id string, cascade bool) error {
+	taskResp, _, err := client.LambdaApi.
+		DeleteLambda(ctx, id).
+		Cascade(cascade).
+		Execute()
+	if err != nil {
+		return fmt.Errorf("error when calling `LambdaApi.DeleteLambda``: %v", err)
+	}
+
+	res, err := watchTask(ctx, taskResp.GetTask())
+	if err != nil {
+		return err
+	}
+
+	if res.GetStatus() == api.TaskFailed {
+		return fmt.Errorf("error when deleting lambda: %v", res.GetDetails()["error"])
+	}
+
+	return nil
+}
+
+// DeployLambda creates the lambda and starts it, image build output is passed to progress line by line.
+func DeployLambda(ctx context.Context, input CreateLambdaM, path string, progress func(line string)) (*api.Lambda, error) {
 	lambda, err := CreateLambda(ctx, input, path)
 	if err != nil {
 		return nil, err
 	}
 
-	return StartLambda(ctx, lambda.GetId())
+	return StartLambda(ctx, lambda.GetId(), progress)
 }
@@ -0,0 +1,103 @@
+package ops
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	api "github.com/hedlx/doless/client"
+)
+
+type LambdaLogsM struct {
+	Since  string
+	Tail   int64
+	Follow bool
+}
+
+// LambdaLogs passes lambda log lines to print, following them until ctx is done or lambda stops.
+// Negative tail means all lines.
+func LambdaLogs(ctx context.Context, id string, opts LambdaLogsM, print func(api.LambdaLogLine)) error {
+	if opts.Follow {
+		return followLambdaLogs(ctx, id, opts, print)
+	}
+
+	req := client.LambdaApi.GetLambdaLogs(ctx, id)
+	if opts.Since != "" {
+		req = req.Since(opts.Since)
+	}
+
+	if opts.Tail >= 0 {
+		req = req.Tail(opts.Tail)
+	}
+
+	lines, r, err := req.Execute()
+	if err != nil {
+		var details api.Error
+		json.NewDecoder(r.Body).Decode(&details)
+		return fmt.Errorf("error when calling `LambdaApi.GetLambdaLogs``: %v\n%v", err, details.GetError())
+	}
+
+	for _, line := range lines {
+		print(line)
+	}
+
+	return nil
+}
+
+// followLambdaLogs reads server-sent events, which generated client doesn't support.
+func followLambdaLogs(ctx context.Context, id string, opts LambdaLogsM, print func(api.LambdaLogLine)) error {
+	query := url.Values{}
+	query.Set("follow", "true")
+	if opts.Since != "" {
+		query.Set("since", opts.Since)
+	}
+
+	if opts.Tail >= 0 {
+		query.Set("tail", strconv.FormatInt(opts.Tail, 10))
+	}
+
+	endpoint := fmt.Sprintf("%s/lambda/%s/logs?%s", client.GetConfig().Servers[0].URL, url.PathEscape(id), query.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error when following lambda logs: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var details api.Error
+		json.NewDecoder(resp.Body).Decode(&details)
+		return fmt.Errorf("error when following lambda logs: %s\n%v", resp.Status, details.GetError())
+	}
+
+	err = api.ReadEvents(resp.Body, func(event string, data string) error {
+		switch event {
+		case "log":
+			var line api.LambdaLogLine
+			if err := json.Unmarshal([]byte(data), &line); err != nil {
+				return err
+			}
+
+			print(line)
+		case "error":
+			var details api.Error
+			json.Unmarshal([]byte(data), &details)
+			return fmt.Errorf("error when following lambda logs: %v", details.GetError())
+		}
+
+		return nil
+	})
+
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	return err
+}
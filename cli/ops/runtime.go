@@ -2,22 +2,36 @@ package ops
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	api "github.com/hedlx/doless/client"
 )
 
-func CreateRuntime(ctx context.Context, name string, path string) (*api.Runtime, error) {
-	uploadID, err := upload(ctx, path, false)
+// CreateRuntime uploads runtime Dockerfile together with the optional base image Dockerfile and creates the runtime.
+func CreateRuntime(ctx context.Context, name string, path string, basePath string, params []api.RuntimeParameter) (*api.Runtime, error) {
+	uploadID, err := upload(ctx, path)
 	if err != nil {
 		return nil, err
 	}
 
+	var baseID *string
+	if basePath != "" {
+		id, err := upload(ctx, basePath)
+		if err != nil {
+			return nil, err
+		}
+
+		baseID = &id
+	}
+
 	createResp, _, err := client.RuntimeApi.
 		CreateRuntime(ctx).
 		CreateRuntime(api.CreateRuntime{
-			Name:       name,
-			Dockerfile: uploadID,
+			Name:           name,
+			Dockerfile:     uploadID,
+			BaseDockerfile: baseID,
+			Parameters:     params,
 		}).
 		Execute()
 	if err != nil {
@@ -48,3 +62,27 @@ func ListRuntimes(ctx context.Context) ([]api.Runtime, error) {
 
 	return listResp, nil
 }
+
+// RolloutRuntime rebuilds lambdas built with older runtime revisions passing rollout progress to progress.
+func RolloutRuntime(ctx context.Context, id string, batch int64, progress func(line string)) error {
+	taskResp, r, err := client.RuntimeApi.
+		RolloutRuntime(ctx, id).
+		Batch(batch).
+		Execute()
+	if err != nil {
+		var details api.Error
+		json.NewDecoder(r.Body).Decode(&details)
+		return fmt.Errorf("error when calling `RuntimeApi.RolloutRuntime``: %v\n%v", err, details.GetError())
+	}
+
+	res, err := watchTaskProgress(ctx, taskResp.GetTask(), progress)
+	if err != nil {
+		return err
+	}
+
+	if res.GetStatus() == api.TaskFailed {
+		return fmt.Errorf("error when rolling out runtime: %v", res.GetDetails()["error"])
+	}
+
+	return nil
+}
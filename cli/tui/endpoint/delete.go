@@ -0,0 +1,70 @@
+package endpoint
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type EndpointDeleteResponseMsg struct {
+	Resp *EndpointDeleteResponse
+}
+
+type EndpointDeleteResponse struct {
+	Err error
+}
+
+type EndpointDeleter interface {
+	Delete(id string) tea.Cmd
+}
+
+type EndpointDeleteModel struct {
+	EndpointID string
+	Deleter    EndpointDeleter
+
+	resp *EndpointDeleteResponse
+
+	loadingSpinner spinner.Model
+}
+
+func InitEndpointDeleteModel(m *EndpointDeleteModel) *EndpointDeleteModel {
+	m.loadingSpinner = spinner.New()
+
+	m.loadingSpinner.Spinner = spinner.Dot
+
+	return m
+}
+
+func (m EndpointDeleteModel) Init() tea.Cmd {
+	return tea.Batch(m.Deleter.Delete(m.EndpointID), m.loadingSpinner.Tick)
+}
+
+func (m EndpointDeleteModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyCtrlC:
+			return m, tea.Quit
+		}
+	case EndpointDeleteResponseMsg:
+		m.resp = msg.Resp
+		return m, tea.Quit
+	}
+
+	var cmd tea.Cmd
+	m.loadingSpinner, cmd = m.loadingSpinner.Update(msg)
+	return m, cmd
+}
+
+func (m EndpointDeleteModel) View() string {
+	if m.resp == nil {
+		return fmt.Sprintf("%s Deleting endpoint...", m.loadingSpinner.View())
+	}
+
+	if m.resp.Err != nil {
+		return fmt.Sprintf("Failed to delete endpoint: %s\n", m.resp.Err)
+	} else {
+		return "Endpoint has been deleted\n"
+	}
+}
@@ -0,0 +1,75 @@
+package endpoint
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	api "github.com/hedlx/doless/client"
+)
+
+type EndpointUpdateResponseMsg struct {
+	Resp *EndpointUpdateResponse
+}
+
+type EndpointUpdateResponse struct {
+	Endpoint *api.Endpoint
+	Err      error
+}
+
+type EndpointUpdater interface {
+	Update(id string, req *api.UpdateEndpoint) tea.Cmd
+}
+
+type EndpointUpdateModel struct {
+	EndpointID string
+	Req        *api.UpdateEndpoint
+	Updater    EndpointUpdater
+
+	resp *EndpointUpdateResponse
+
+	loadingSpinner spinner.Model
+}
+
+func InitEndpointUpdateModel(m *EndpointUpdateModel) *EndpointUpdateModel {
+	m.loadingSpinner = spinner.New()
+
+	m.loadingSpinner.Spinner = spinner.Dot
+
+	return m
+}
+
+func (m EndpointUpdateModel) Init() tea.Cmd {
+	return tea.Batch(m.Updater.Update(m.EndpointID, m.Req), m.loadingSpinner.Tick)
+}
+
+func (m EndpointUpdateModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyCtrlC:
+			return m, tea.Quit
+		}
+	case EndpointUpdateResponseMsg:
+		m.resp = msg.Resp
+		return m, tea.Quit
+	}
+
+	var cmd tea.Cmd
+	m.loadingSpinner, cmd = m.loadingSpinner.Update(msg)
+	return m, cmd
+}
+
+func (m EndpointUpdateModel) View() string {
+	if m.resp == nil {
+		return fmt.Sprintf("%s Updating endpoint...", m.loadingSpinner.View())
+	}
+
+	if m.resp.Err != nil {
+		return fmt.Sprintf("Failed to update endpoint: %s\n", m.resp.Err)
+	} else {
+		j, _ := json.MarshalIndent(m.resp.Endpoint, "", "  ")
+		return string(j) + "\n"
+	}
+}
@@ -0,0 +1,57 @@
+package lambda
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	api "github.com/hedlx/doless/client"
+)
+
+// actionModel shows the spinner while a single lambda request is in progress.
+type actionModel struct {
+	loadingSpinner spinner.Model
+}
+
+func newActionModel() actionModel {
+	m := actionModel{loadingSpinner: spinner.New()}
+
+	m.loadingSpinner.Spinner = spinner.Dot
+
+	return m
+}
+
+// update quits on ctrl+c, any other message is passed to the spinner.
+func (m actionModel) update(msg tea.Msg) (actionModel, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+
+	var cmd tea.Cmd
+	m.loadingSpinner, cmd = m.loadingSpinner.Update(msg)
+	return m, cmd
+}
+
+func (m actionModel) view(status string) string {
+	return fmt.Sprintf("%s %s", m.loadingSpinner.View(), status)
+}
+
+// lambdaView shows the lambda returned by the request, action is used in the error, e.g. "stop".
+func lambdaView(action string, lambda *api.Lambda, err error) string {
+	if err != nil {
+		return fmt.Sprintf("Failed to %s lambda: %s\n", action, err)
+	}
+
+	j, _ := json.MarshalIndent(lambda, "", "  ")
+	return string(j) + "\n"
+}
+
+// doneView shows the result of the request which doesn't return the lambda.
+func doneView(action string, done string, err error) string {
+	if err != nil {
+		return fmt.Sprintf("Failed to %s lambda: %s\n", action, err)
+	}
+
+	return done + "\n"
+}
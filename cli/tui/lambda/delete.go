@@ -0,0 +1,54 @@
+package lambda
+
+import tea "github.com/charmbracelet/bubbletea"
+
+type LambdaDeleteResponseMsg struct {
+	Resp *LambdaDeleteResponse
+}
+
+type LambdaDeleteResponse struct {
+	Err error
+}
+
+type LambdaDeleter interface {
+	Delete(id string, cascade bool) tea.Cmd
+}
+
+type LambdaDeleteModel struct {
+	LambdaID string
+	Cascade  bool
+	Deleter  LambdaDeleter
+
+	resp *LambdaDeleteResponse
+
+	action actionModel
+}
+
+func InitLambdaDeleteModel(m *LambdaDeleteModel) *LambdaDeleteModel {
+	m.action = newActionModel()
+
+	return m
+}
+
+func (m LambdaDeleteModel) Init() tea.Cmd {
+	return tea.Batch(m.Deleter.Delete(m.LambdaID, m.Cascade), m.action.loadingSpinner.Tick)
+}
+
+func (m LambdaDeleteModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(LambdaDeleteResponseMsg); ok {
+		m.resp = msg.Resp
+		return m, tea.Quit
+	}
+
+	var cmd tea.Cmd
+	m.action, cmd = m.action.update(msg)
+	return m, cmd
+}
+
+func (m LambdaDeleteModel) View() string {
+	if m.resp == nil {
+		return m.action.view("Deleting lambda...")
+	}
+
+	return doneView("delete", "Lambda has been deleted", m.resp.Err)
+}
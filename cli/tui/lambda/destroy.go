@@ -1,11 +1,6 @@
 package lambda
 
-import (
-	"fmt"
-
-	"github.com/charmbracelet/bubbles/spinner"
-	tea "github.com/charmbracelet/bubbletea"
-)
+import tea "github.com/charmbracelet/bubbletea"
 
 type LambdaDestroyResponseMsg struct {
 	Resp *LambdaDestroyResponse
@@ -25,46 +20,34 @@ type LambdaDestroyModel struct {
 
 	resp *LambdaDestroyResponse
 
-	loadingSpinner spinner.Model
+	action actionModel
 }
 
 func InitLambdaDestroyModel(m *LambdaDestroyModel) *LambdaDestroyModel {
-	m.loadingSpinner = spinner.New()
-
-	m.loadingSpinner.Spinner = spinner.Dot
+	m.action = newActionModel()
 
 	return m
 }
 
 func (m LambdaDestroyModel) Init() tea.Cmd {
-	return tea.Batch(m.Destroyer.Destroy(m.LambdaID), m.loadingSpinner.Tick)
+	return tea.Batch(m.Destroyer.Destroy(m.LambdaID), m.action.loadingSpinner.Tick)
 }
 
 func (m LambdaDestroyModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	switch msg := msg.(type) {
-	case tea.KeyMsg:
-		switch msg.Type {
-		case tea.KeyCtrlC:
-			return m, tea.Quit
-		}
-	case LambdaDestroyResponseMsg:
+	if msg, ok := msg.(LambdaDestroyResponseMsg); ok {
 		m.resp = msg.Resp
 		return m, tea.Quit
 	}
 
 	var cmd tea.Cmd
-	m.loadingSpinner, cmd = m.loadingSpinner.Update(msg)
+	m.action, cmd = m.action.update(msg)
 	return m, cmd
 }
 
 func (m LambdaDestroyModel) View() string {
 	if m.resp == nil {
-		return fmt.Sprintf("%s Destroying lambda...", m.loadingSpinner.View())
+		return m.action.view("Destroying lambda...")
 	}
 
-	if m.resp.Err != nil {
-		return fmt.Sprintf("Failed to destroy lambda: %s\n", m.resp.Err)
-	} else {
-		return "Lambda has been destroyed\n"
-	}
+	return doneView("destroy", "Lambda has been destroyed", m.resp.Err)
 }
@@ -0,0 +1,58 @@
+package lambda
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	api "github.com/hedlx/doless/client"
+)
+
+type LambdaScaleResponseMsg struct {
+	Resp *LambdaScaleResponse
+}
+
+type LambdaScaleResponse struct {
+	Lambda *api.Lambda
+	Err    error
+}
+
+type LambdaScaler interface {
+	Scale(id string, replicas int64) tea.Cmd
+}
+
+type LambdaScaleModel struct {
+	LambdaID string
+	Replicas int64
+	Scaler   LambdaScaler
+
+	resp *LambdaScaleResponse
+
+	action actionModel
+}
+
+func InitLambdaScaleModel(m *LambdaScaleModel) *LambdaScaleModel {
+	m.action = newActionModel()
+
+	return m
+}
+
+func (m LambdaScaleModel) Init() tea.Cmd {
+	return tea.Batch(m.Scaler.Scale(m.LambdaID, m.Replicas), m.action.loadingSpinner.Tick)
+}
+
+func (m LambdaScaleModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(LambdaScaleResponseMsg); ok {
+		m.resp = msg.Resp
+		return m, tea.Quit
+	}
+
+	var cmd tea.Cmd
+	m.action, cmd = m.action.update(msg)
+	return m, cmd
+}
+
+func (m LambdaScaleModel) View() string {
+	if m.resp == nil {
+		return m.action.view("Scaling lambda...")
+	}
+
+	return lambdaView("scale", m.resp.Lambda, m.resp.Err)
+}
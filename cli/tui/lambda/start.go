@@ -1,10 +1,8 @@
 package lambda
 
 import (
-	"encoding/json"
-	"fmt"
+	"strings"
 
-	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	api "github.com/hedlx/doless/client"
 )
@@ -13,62 +11,91 @@ type LambdaStartResponseMsg struct {
 	Resp *LambdaStartResponse
 }
 
+type LambdaStartProgressMsg struct {
+	Line string
+}
+
 type LambdaStartResponse struct {
 	Lambda *api.Lambda
 	Err    error
 }
 
 type LambdaStarter interface {
-	Start(id string) tea.Cmd
+	// Start passes build output to progress and closes it before the response
+	Start(id string, progress chan<- string) tea.Cmd
 }
 
+// startProgressLines is the number of the last build lines shown while lambda is starting.
+const startProgressLines = 10
+
 type LambdaStartModel struct {
 	LambdaID string
 	Starter  LambdaStarter
 
-	resp *LambdaStartResponse
+	resp     *LambdaStartResponse
+	progress chan string
+	lines    []string
 
-	loadingSpinner spinner.Model
+	action actionModel
 }
 
 func InitLambdaStartModel(m *LambdaStartModel) *LambdaStartModel {
-	m.loadingSpinner = spinner.New()
-
-	m.loadingSpinner.Spinner = spinner.Dot
+	m.action = newActionModel()
+	m.progress = make(chan string)
 
 	return m
 }
 
 func (m LambdaStartModel) Init() tea.Cmd {
-	return tea.Batch(m.Starter.Start(m.LambdaID), m.loadingSpinner.Tick)
+	return tea.Batch(m.Starter.Start(m.LambdaID, m.progress), m.waitProgress(), m.action.loadingSpinner.Tick)
+}
+
+func (m LambdaStartModel) waitProgress() tea.Cmd {
+	return func() tea.Msg {
+		line, ok := <-m.progress
+		if !ok {
+			return nil
+		}
+
+		return LambdaStartProgressMsg{Line: line}
+	}
 }
 
 func (m LambdaStartModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
-	case tea.KeyMsg:
-		switch msg.Type {
-		case tea.KeyCtrlC:
-			return m, tea.Quit
-		}
+	case LambdaStartProgressMsg:
+		m.lines = append(m.lines, msg.Line)
+		return m, m.waitProgress()
 	case LambdaStartResponseMsg:
 		m.resp = msg.Resp
 		return m, tea.Quit
 	}
 
 	var cmd tea.Cmd
-	m.loadingSpinner, cmd = m.loadingSpinner.Update(msg)
+	m.action, cmd = m.action.update(msg)
 	return m, cmd
 }
 
 func (m LambdaStartModel) View() string {
 	if m.resp == nil {
-		return fmt.Sprintf("%s Starting lambda...", m.loadingSpinner.View())
+		lines := m.lines
+		if len(lines) > startProgressLines {
+			lines = lines[len(lines)-startProgressLines:]
+		}
+
+		view := m.action.view("Starting lambda...\n")
+		for _, line := range lines {
+			view += "  " + line + "\n"
+		}
+
+		return view
 	}
 
-	if m.resp.Err != nil {
-		return fmt.Sprintf("Failed to start lambda: %s\n", m.resp.Err)
-	} else {
-		j, _ := json.MarshalIndent(m.resp.Lambda, "", "  ")
-		return string(j) + "\n"
+	// Full build output helps to find out what went wrong
+	output := ""
+	if m.resp.Err != nil && len(m.lines) > 0 {
+		output = strings.Join(m.lines, "\n") + "\n"
 	}
+
+	return output + lambdaView("start", m.resp.Lambda, m.resp.Err)
 }
@@ -0,0 +1,57 @@
+package lambda
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	api "github.com/hedlx/doless/client"
+)
+
+type LambdaStopResponseMsg struct {
+	Resp *LambdaStopResponse
+}
+
+type LambdaStopResponse struct {
+	Lambda *api.Lambda
+	Err    error
+}
+
+type LambdaStopper interface {
+	Stop(id string) tea.Cmd
+}
+
+type LambdaStopModel struct {
+	LambdaID string
+	Stopper  LambdaStopper
+
+	resp *LambdaStopResponse
+
+	action actionModel
+}
+
+func InitLambdaStopModel(m *LambdaStopModel) *LambdaStopModel {
+	m.action = newActionModel()
+
+	return m
+}
+
+func (m LambdaStopModel) Init() tea.Cmd {
+	return tea.Batch(m.Stopper.Stop(m.LambdaID), m.action.loadingSpinner.Tick)
+}
+
+func (m LambdaStopModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(LambdaStopResponseMsg); ok {
+		m.resp = msg.Resp
+		return m, tea.Quit
+	}
+
+	var cmd tea.Cmd
+	m.action, cmd = m.action.update(msg)
+	return m, cmd
+}
+
+func (m LambdaStopModel) View() string {
+	if m.resp == nil {
+		return m.action.view("Stopping lambda...")
+	}
+
+	return lambdaView("stop", m.resp.Lambda, m.resp.Err)
+}
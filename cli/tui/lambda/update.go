@@ -0,0 +1,58 @@
+package lambda
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	api "github.com/hedlx/doless/client"
+)
+
+type LambdaUpdateResponseMsg struct {
+	Resp *LambdaUpdateResponse
+}
+
+type LambdaUpdateResponse struct {
+	Lambda *api.Lambda
+	Err    error
+}
+
+type LambdaUpdater interface {
+	Update(id string, path string) tea.Cmd
+}
+
+type LambdaUpdateModel struct {
+	LambdaID string
+	Path     string
+	Updater  LambdaUpdater
+
+	resp *LambdaUpdateResponse
+
+	action actionModel
+}
+
+func InitLambdaUpdateModel(m *LambdaUpdateModel) *LambdaUpdateModel {
+	m.action = newActionModel()
+
+	return m
+}
+
+func (m LambdaUpdateModel) Init() tea.Cmd {
+	return tea.Batch(m.Updater.Update(m.LambdaID, m.Path), m.action.loadingSpinner.Tick)
+}
+
+func (m LambdaUpdateModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(LambdaUpdateResponseMsg); ok {
+		m.resp = msg.Resp
+		return m, tea.Quit
+	}
+
+	var cmd tea.Cmd
+	m.action, cmd = m.action.update(msg)
+	return m, cmd
+}
+
+func (m LambdaUpdateModel) View() string {
+	if m.resp == nil {
+		return m.action.view("Updating lambda...")
+	}
+
+	return lambdaView("update", m.resp.Lambda, m.resp.Err)
+}
@@ -1,8 +1,8 @@
 package runtime
 
 import (
-	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
@@ -66,8 +66,41 @@ func (m RuntimeListModel) View() string {
 
 	if m.resp.Err != nil {
 		return fmt.Sprintf("Failed to create runtime: %s\n", m.resp.Err)
+	}
+
+	var b strings.Builder
+	for _, runtime := range m.resp.Runtimes {
+		fmt.Fprintf(&b, "%s (%s), revision %d", runtime.Name, runtime.Id, runtime.Revision)
+		if base := runtime.BaseImage; base != nil {
+			fmt.Fprintf(&b, ", base image %s", base.Status)
+		}
+		b.WriteString("\n")
+
+		for _, param := range runtime.Parameters {
+			b.WriteString("  " + viewParameter(param) + "\n")
+		}
+	}
+
+	return b.String()
+}
+
+// viewParameter describes runtime parameter which lambdas set with build args.
+func viewParameter(param api.RuntimeParameter) string {
+	line := fmt.Sprintf("%s: %s", param.Name, param.Kind)
+
+	if len(param.Values) > 0 {
+		line += " (" + strings.Join(param.Values, " | ") + ")"
+	}
+
+	if param.Default != nil {
+		line += ", default " + *param.Default
 	} else {
-		j, _ := json.MarshalIndent(m.resp.Runtimes, "", "  ")
-		return string(j) + "\n"
+		line += ", required"
 	}
+
+	if param.Description != nil {
+		line += " - " + *param.Description
+	}
+
+	return line
 }
@@ -139,25 +139,1803 @@ func (a *LambdaApiService) CreateLambdaExecute(r ApiCreateLambdaRequest) (*Lambd
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiCreateLambdaVersionRequest struct {
+	ctx context.Context
+	ApiService *LambdaApiService
+	id string
+	createLambdaVersion *CreateLambdaVersion
+}
+
+// Create lambda version body
+func (r ApiCreateLambdaVersionRequest) CreateLambdaVersion(createLambdaVersion CreateLambdaVersion) ApiCreateLambdaVersionRequest {
+	r.createLambdaVersion = &createLambdaVersion
+	return r
+}
+
+func (r ApiCreateLambdaVersionRequest) Execute() (*LambdaVersion, *http.Response, error) {
+	return r.ApiService.CreateLambdaVersionExecute(r)
+}
+
+/*
+CreateLambdaVersion Create new lambda version
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param id lambda id
+ @return ApiCreateLambdaVersionRequest
+*/
+func (a *LambdaApiService) CreateLambdaVersion(ctx context.Context, id string) ApiCreateLambdaVersionRequest {
+	return ApiCreateLambdaVersionRequest{
+		ApiService: a,
+		ctx: ctx,
+		id: id,
+	}
+}
+
+// Execute executes the request
+//  @return LambdaVersion
+func (a *LambdaApiService) CreateLambdaVersionExecute(r ApiCreateLambdaVersionRequest) (*LambdaVersion, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPost
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *LambdaVersion
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.CreateLambdaVersion")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/lambda/{id}/version"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterToString(r.id, "")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.createLambdaVersion == nil {
+		return localVarReturnValue, nil, reportError("createLambdaVersion is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.createLambdaVersion
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiDeleteLambdaRequest struct {
+	ctx context.Context
+	ApiService *LambdaApiService
+	id string
+	cascade *bool
+}
+
+// delete endpoints of the lambda instead of refusing
+func (r ApiDeleteLambdaRequest) Cascade(cascade bool) ApiDeleteLambdaRequest {
+	r.cascade = &cascade
+	return r
+}
+
+func (r ApiDeleteLambdaRequest) Execute() (*TaskResponse, *http.Response, error) {
+	return r.ApiService.DeleteLambdaExecute(r)
+}
+
+/*
+DeleteLambda Delete lambda with its container, image, code and metadata
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param id lambda id
+ @return ApiDeleteLambdaRequest
+*/
+func (a *LambdaApiService) DeleteLambda(ctx context.Context, id string) ApiDeleteLambdaRequest {
+	return ApiDeleteLambdaRequest{
+		ApiService: a,
+		ctx: ctx,
+		id: id,
+	}
+}
+
+// Execute executes the request
+//  @return TaskResponse
+func (a *LambdaApiService) DeleteLambdaExecute(r ApiDeleteLambdaRequest) (*TaskResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodDelete
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *TaskResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.DeleteLambda")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/lambda/{id}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterToString(r.id, "")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.cascade != nil {
+		localVarQueryParams.Add("cascade", parameterToString(*r.cascade, ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiDeleteLambdaAliasRequest struct {
+	ctx context.Context
+	ApiService *LambdaApiService
+	id string
+	alias string
+}
+
+func (r ApiDeleteLambdaAliasRequest) Execute() (*Lambda, *http.Response, error) {
+	return r.ApiService.DeleteLambdaAliasExecute(r)
+}
+
+/*
+DeleteLambdaAlias Delete lambda alias
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param id lambda id
+ @param alias alias name
+ @return ApiDeleteLambdaAliasRequest
+*/
+func (a *LambdaApiService) DeleteLambdaAlias(ctx context.Context, id string, alias string) ApiDeleteLambdaAliasRequest {
+	return ApiDeleteLambdaAliasRequest{
+		ApiService: a,
+		ctx: ctx,
+		id: id,
+		alias: alias,
+	}
+}
+
+// Execute executes the request
+//  @return Lambda
+func (a *LambdaApiService) DeleteLambdaAliasExecute(r ApiDeleteLambdaAliasRequest) (*Lambda, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodDelete
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *Lambda
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.DeleteLambdaAlias")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/lambda/{id}/alias/{alias}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterToString(r.id, "")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"alias"+"}", url.PathEscape(parameterToString(r.alias, "")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiDestroyLambdaRequest struct {
 	ctx context.Context
 	ApiService *LambdaApiService
 	id string
 }
 
-func (r ApiDestroyLambdaRequest) Execute() (*TaskResponse, *http.Response, error) {
-	return r.ApiService.DestroyLambdaExecute(r)
+func (r ApiDestroyLambdaRequest) Execute() (*TaskResponse, *http.Response, error) {
+	return r.ApiService.DestroyLambdaExecute(r)
+}
+
+/*
+DestroyLambda Stop lambda and remove docker container
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param id lambda id
+ @return ApiDestroyLambdaRequest
+*/
+func (a *LambdaApiService) DestroyLambda(ctx context.Context, id string) ApiDestroyLambdaRequest {
+	return ApiDestroyLambdaRequest{
+		ApiService: a,
+		ctx: ctx,
+		id: id,
+	}
+}
+
+// Execute executes the request
+//  @return TaskResponse
+func (a *LambdaApiService) DestroyLambdaExecute(r ApiDestroyLambdaRequest) (*TaskResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPost
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *TaskResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.DestroyLambda")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/lambda/{id}/destroy"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterToString(r.id, "")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetLambdaRequest struct {
+	ctx context.Context
+	ApiService *LambdaApiService
+	id string
+}
+
+func (r ApiGetLambdaRequest) Execute() (*Lambda, *http.Response, error) {
+	return r.ApiService.GetLambdaExecute(r)
+}
+
+/*
+GetLambda Get lambda
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param id lambda id
+ @return ApiGetLambdaRequest
+*/
+func (a *LambdaApiService) GetLambda(ctx context.Context, id string) ApiGetLambdaRequest {
+	return ApiGetLambdaRequest{
+		ApiService: a,
+		ctx: ctx,
+		id: id,
+	}
+}
+
+// Execute executes the request
+//  @return Lambda
+func (a *LambdaApiService) GetLambdaExecute(r ApiGetLambdaRequest) (*Lambda, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *Lambda
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.GetLambda")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/lambda/{id}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterToString(r.id, "")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetLambdaLogsRequest struct {
+	ctx context.Context
+	ApiService *LambdaApiService
+	id string
+	since *string
+	tail *int64
+	stdout *bool
+	stderr *bool
+	replica *int64
+	follow *bool
+}
+
+// unix timestamp or relative duration like 10m
+func (r ApiGetLambdaLogsRequest) Since(since string) ApiGetLambdaLogsRequest {
+	r.since = &since
+	return r
+}
+
+// number of the last lines of every replica
+func (r ApiGetLambdaLogsRequest) Tail(tail int64) ApiGetLambdaLogsRequest {
+	r.tail = &tail
+	return r
+}
+
+// include stdout, true by default
+func (r ApiGetLambdaLogsRequest) Stdout(stdout bool) ApiGetLambdaLogsRequest {
+	r.stdout = &stdout
+	return r
+}
+
+// include stderr, true by default
+func (r ApiGetLambdaLogsRequest) Stderr(stderr bool) ApiGetLambdaLogsRequest {
+	r.stderr = &stderr
+	return r
+}
+
+// replica index, all replicas by default
+func (r ApiGetLambdaLogsRequest) Replica(replica int64) ApiGetLambdaLogsRequest {
+	r.replica = &replica
+	return r
+}
+
+// stream new lines as server-sent events until the client disconnects
+func (r ApiGetLambdaLogsRequest) Follow(follow bool) ApiGetLambdaLogsRequest {
+	r.follow = &follow
+	return r
+}
+
+func (r ApiGetLambdaLogsRequest) Execute() ([]LambdaLogLine, *http.Response, error) {
+	return r.ApiService.GetLambdaLogsExecute(r)
+}
+
+/*
+GetLambdaLogs Get lambda container logs, streamed as server-sent "log" events with follow
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param id lambda id
+ @return ApiGetLambdaLogsRequest
+*/
+func (a *LambdaApiService) GetLambdaLogs(ctx context.Context, id string) ApiGetLambdaLogsRequest {
+	return ApiGetLambdaLogsRequest{
+		ApiService: a,
+		ctx: ctx,
+		id: id,
+	}
+}
+
+// Execute executes the request
+//  @return []LambdaLogLine
+func (a *LambdaApiService) GetLambdaLogsExecute(r ApiGetLambdaLogsRequest) ([]LambdaLogLine, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  []LambdaLogLine
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.GetLambdaLogs")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/lambda/{id}/logs"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterToString(r.id, "")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.since != nil {
+		localVarQueryParams.Add("since", parameterToString(*r.since, ""))
+	}
+	if r.tail != nil {
+		localVarQueryParams.Add("tail", parameterToString(*r.tail, ""))
+	}
+	if r.stdout != nil {
+		localVarQueryParams.Add("stdout", parameterToString(*r.stdout, ""))
+	}
+	if r.stderr != nil {
+		localVarQueryParams.Add("stderr", parameterToString(*r.stderr, ""))
+	}
+	if r.replica != nil {
+		localVarQueryParams.Add("replica", parameterToString(*r.replica, ""))
+	}
+	if r.follow != nil {
+		localVarQueryParams.Add("follow", parameterToString(*r.follow, ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetLambdaMetricsRequest struct {
+	ctx context.Context
+	ApiService *LambdaApiService
+	id string
+}
+
+func (r ApiGetLambdaMetricsRequest) Execute() (*LambdaMetrics, *http.Response, error) {
+	return r.ApiService.GetLambdaMetricsExecute(r)
+}
+
+/*
+GetLambdaMetrics Get lambda request metrics reported by the handler
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param id lambda id
+ @return ApiGetLambdaMetricsRequest
+*/
+func (a *LambdaApiService) GetLambdaMetrics(ctx context.Context, id string) ApiGetLambdaMetricsRequest {
+	return ApiGetLambdaMetricsRequest{
+		ApiService: a,
+		ctx: ctx,
+		id: id,
+	}
+}
+
+// Execute executes the request
+//  @return LambdaMetrics
+func (a *LambdaApiService) GetLambdaMetricsExecute(r ApiGetLambdaMetricsRequest) (*LambdaMetrics, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *LambdaMetrics
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.GetLambdaMetrics")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/lambda/{id}/metrics"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterToString(r.id, "")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiListLambdaEventsRequest struct {
+	ctx context.Context
+	ApiService *LambdaApiService
+	id string
+}
+
+func (r ApiListLambdaEventsRequest) Execute() ([]LambdaEvent, *http.Response, error) {
+	return r.ApiService.ListLambdaEventsExecute(r)
+}
+
+/*
+ListLambdaEvents List lambda events, oldest first
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param id lambda id
+ @return ApiListLambdaEventsRequest
+*/
+func (a *LambdaApiService) ListLambdaEvents(ctx context.Context, id string) ApiListLambdaEventsRequest {
+	return ApiListLambdaEventsRequest{
+		ApiService: a,
+		ctx: ctx,
+		id: id,
+	}
+}
+
+// Execute executes the request
+//  @return []LambdaEvent
+func (a *LambdaApiService) ListLambdaEventsExecute(r ApiListLambdaEventsRequest) ([]LambdaEvent, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  []LambdaEvent
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.ListLambdaEvents")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/lambda/{id}/events"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterToString(r.id, "")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiListLambdasRequest struct {
+	ctx context.Context
+	ApiService *LambdaApiService
+}
+
+func (r ApiListLambdasRequest) Execute() ([]Lambda, *http.Response, error) {
+	return r.ApiService.ListLambdasExecute(r)
+}
+
+/*
+ListLambdas List lambdas
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiListLambdasRequest
+*/
+func (a *LambdaApiService) ListLambdas(ctx context.Context) ApiListLambdasRequest {
+	return ApiListLambdasRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return []Lambda
+func (a *LambdaApiService) ListLambdasExecute(r ApiListLambdasRequest) ([]Lambda, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  []Lambda
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.ListLambdas")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/lambda"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiScaleLambdaRequest struct {
+	ctx context.Context
+	ApiService *LambdaApiService
+	id string
+	scaleLambda *ScaleLambda
+}
+
+// Scale lambda body
+func (r ApiScaleLambdaRequest) ScaleLambda(scaleLambda ScaleLambda) ApiScaleLambdaRequest {
+	r.scaleLambda = &scaleLambda
+	return r
+}
+
+func (r ApiScaleLambdaRequest) Execute() (*TaskResponse, *http.Response, error) {
+	return r.ApiService.ScaleLambdaExecute(r)
+}
+
+/*
+ScaleLambda Change number of lambda containers
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param id lambda id
+ @return ApiScaleLambdaRequest
+*/
+func (a *LambdaApiService) ScaleLambda(ctx context.Context, id string) ApiScaleLambdaRequest {
+	return ApiScaleLambdaRequest{
+		ApiService: a,
+		ctx: ctx,
+		id: id,
+	}
+}
+
+// Execute executes the request
+//  @return TaskResponse
+func (a *LambdaApiService) ScaleLambdaExecute(r ApiScaleLambdaRequest) (*TaskResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPut
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *TaskResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.ScaleLambda")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/lambda/{id}/replicas"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterToString(r.id, "")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.scaleLambda == nil {
+		return localVarReturnValue, nil, reportError("scaleLambda is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.scaleLambda
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiSetLambdaAliasRequest struct {
+	ctx context.Context
+	ApiService *LambdaApiService
+	id string
+	alias string
+	setLambdaAlias *SetLambdaAlias
+}
+
+// Set lambda alias body
+func (r ApiSetLambdaAliasRequest) SetLambdaAlias(setLambdaAlias SetLambdaAlias) ApiSetLambdaAliasRequest {
+	r.setLambdaAlias = &setLambdaAlias
+	return r
+}
+
+func (r ApiSetLambdaAliasRequest) Execute() (*Lambda, *http.Response, error) {
+	return r.ApiService.SetLambdaAliasExecute(r)
+}
+
+/*
+SetLambdaAlias Point lambda alias to a version
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param id lambda id
+ @param alias alias name
+ @return ApiSetLambdaAliasRequest
+*/
+func (a *LambdaApiService) SetLambdaAlias(ctx context.Context, id string, alias string) ApiSetLambdaAliasRequest {
+	return ApiSetLambdaAliasRequest{
+		ApiService: a,
+		ctx: ctx,
+		id: id,
+		alias: alias,
+	}
+}
+
+// Execute executes the request
+//  @return Lambda
+func (a *LambdaApiService) SetLambdaAliasExecute(r ApiSetLambdaAliasRequest) (*Lambda, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPut
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *Lambda
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.SetLambdaAlias")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/lambda/{id}/alias/{alias}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterToString(r.id, "")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"alias"+"}", url.PathEscape(parameterToString(r.alias, "")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.setLambdaAlias == nil {
+		return localVarReturnValue, nil, reportError("setLambdaAlias is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.setLambdaAlias
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiSetLambdaAutoscalingRequest struct {
+	ctx context.Context
+	ApiService *LambdaApiService
+	id string
+	setLambdaAutoscaling *SetLambdaAutoscaling
+}
+
+// Set lambda autoscaling body
+func (r ApiSetLambdaAutoscalingRequest) SetLambdaAutoscaling(setLambdaAutoscaling SetLambdaAutoscaling) ApiSetLambdaAutoscalingRequest {
+	r.setLambdaAutoscaling = &setLambdaAutoscaling
+	return r
+}
+
+func (r ApiSetLambdaAutoscalingRequest) Execute() (*Lambda, *http.Response, error) {
+	return r.ApiService.SetLambdaAutoscalingExecute(r)
+}
+
+/*
+SetLambdaAutoscaling Set lambda autoscaling
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param id lambda id
+ @return ApiSetLambdaAutoscalingRequest
+*/
+func (a *LambdaApiService) SetLambdaAutoscaling(ctx context.Context, id string) ApiSetLambdaAutoscalingRequest {
+	return ApiSetLambdaAutoscalingRequest{
+		ApiService: a,
+		ctx: ctx,
+		id: id,
+	}
+}
+
+// Execute executes the request
+//  @return Lambda
+func (a *LambdaApiService) SetLambdaAutoscalingExecute(r ApiSetLambdaAutoscalingRequest) (*Lambda, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPut
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *Lambda
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.SetLambdaAutoscaling")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/lambda/{id}/autoscaling"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterToString(r.id, "")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.setLambdaAutoscaling == nil {
+		return localVarReturnValue, nil, reportError("setLambdaAutoscaling is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.setLambdaAutoscaling
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiSetLambdaEnvRequest struct {
+	ctx context.Context
+	ApiService *LambdaApiService
+	id string
+	setLambdaEnv *SetLambdaEnv
+}
+
+// Set lambda env body
+func (r ApiSetLambdaEnvRequest) SetLambdaEnv(setLambdaEnv SetLambdaEnv) ApiSetLambdaEnvRequest {
+	r.setLambdaEnv = &setLambdaEnv
+	return r
+}
+
+func (r ApiSetLambdaEnvRequest) Execute() (*TaskResponse, *http.Response, error) {
+	return r.ApiService.SetLambdaEnvExecute(r)
+}
+
+/*
+SetLambdaEnv Replace lambda environment and recreate its container
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param id lambda id
+ @return ApiSetLambdaEnvRequest
+*/
+func (a *LambdaApiService) SetLambdaEnv(ctx context.Context, id string) ApiSetLambdaEnvRequest {
+	return ApiSetLambdaEnvRequest{
+		ApiService: a,
+		ctx: ctx,
+		id: id,
+	}
+}
+
+// Execute executes the request
+//  @return TaskResponse
+func (a *LambdaApiService) SetLambdaEnvExecute(r ApiSetLambdaEnvRequest) (*TaskResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPut
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *TaskResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.SetLambdaEnv")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/lambda/{id}/env"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterToString(r.id, "")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.setLambdaEnv == nil {
+		return localVarReturnValue, nil, reportError("setLambdaEnv is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.setLambdaEnv
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiSetLambdaIdleTimeoutRequest struct {
+	ctx context.Context
+	ApiService *LambdaApiService
+	id string
+	setLambdaIdleTimeout *SetLambdaIdleTimeout
+}
+
+// Set lambda idle timeout body
+func (r ApiSetLambdaIdleTimeoutRequest) SetLambdaIdleTimeout(setLambdaIdleTimeout SetLambdaIdleTimeout) ApiSetLambdaIdleTimeoutRequest {
+	r.setLambdaIdleTimeout = &setLambdaIdleTimeout
+	return r
+}
+
+func (r ApiSetLambdaIdleTimeoutRequest) Execute() (*Lambda, *http.Response, error) {
+	return r.ApiService.SetLambdaIdleTimeoutExecute(r)
+}
+
+/*
+SetLambdaIdleTimeout Set lambda idle timeout
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param id lambda id
+ @return ApiSetLambdaIdleTimeoutRequest
+*/
+func (a *LambdaApiService) SetLambdaIdleTimeout(ctx context.Context, id string) ApiSetLambdaIdleTimeoutRequest {
+	return ApiSetLambdaIdleTimeoutRequest{
+		ApiService: a,
+		ctx: ctx,
+		id: id,
+	}
+}
+
+// Execute executes the request
+//  @return Lambda
+func (a *LambdaApiService) SetLambdaIdleTimeoutExecute(r ApiSetLambdaIdleTimeoutRequest) (*Lambda, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPut
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *Lambda
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.SetLambdaIdleTimeout")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/lambda/{id}/idle-timeout"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterToString(r.id, "")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.setLambdaIdleTimeout == nil {
+		return localVarReturnValue, nil, reportError("setLambdaIdleTimeout is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.setLambdaIdleTimeout
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiSetLambdaRestartPolicyRequest struct {
+	ctx context.Context
+	ApiService *LambdaApiService
+	id string
+	setLambdaRestartPolicy *SetLambdaRestartPolicy
+}
+
+// Set lambda restart policy body
+func (r ApiSetLambdaRestartPolicyRequest) SetLambdaRestartPolicy(setLambdaRestartPolicy SetLambdaRestartPolicy) ApiSetLambdaRestartPolicyRequest {
+	r.setLambdaRestartPolicy = &setLambdaRestartPolicy
+	return r
+}
+
+func (r ApiSetLambdaRestartPolicyRequest) Execute() (*Lambda, *http.Response, error) {
+	return r.ApiService.SetLambdaRestartPolicyExecute(r)
 }
 
 /*
-DestroyLambda Stop lambda and remove docker container
+SetLambdaRestartPolicy Set lambda restart policy, it is applied to the running containers too
 
  @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
  @param id lambda id
- @return ApiDestroyLambdaRequest
+ @return ApiSetLambdaRestartPolicyRequest
 */
-func (a *LambdaApiService) DestroyLambda(ctx context.Context, id string) ApiDestroyLambdaRequest {
-	return ApiDestroyLambdaRequest{
+func (a *LambdaApiService) SetLambdaRestartPolicy(ctx context.Context, id string) ApiSetLambdaRestartPolicyRequest {
+	return ApiSetLambdaRestartPolicyRequest{
+		ApiService: a,
+		ctx: ctx,
+		id: id,
+	}
+}
+
+// Execute executes the request
+//  @return Lambda
+func (a *LambdaApiService) SetLambdaRestartPolicyExecute(r ApiSetLambdaRestartPolicyRequest) (*Lambda, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPut
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *Lambda
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.SetLambdaRestartPolicy")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/lambda/{id}/restart-policy"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterToString(r.id, "")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.setLambdaRestartPolicy == nil {
+		return localVarReturnValue, nil, reportError("setLambdaRestartPolicy is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.setLambdaRestartPolicy
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiStartLambdaRequest struct {
+	ctx context.Context
+	ApiService *LambdaApiService
+	id string
+}
+
+func (r ApiStartLambdaRequest) Execute() (*TaskResponse, *http.Response, error) {
+	return r.ApiService.StartLambdaExecute(r)
+}
+
+/*
+StartLambda Start lambda
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param id lambda id
+ @return ApiStartLambdaRequest
+*/
+func (a *LambdaApiService) StartLambda(ctx context.Context, id string) ApiStartLambdaRequest {
+	return ApiStartLambdaRequest{
 		ApiService: a,
 		ctx: ctx,
 		id: id,
@@ -166,7 +1944,7 @@ func (a *LambdaApiService) DestroyLambda(ctx context.Context, id string) ApiDest
 
 // Execute executes the request
 //  @return TaskResponse
-func (a *LambdaApiService) DestroyLambdaExecute(r ApiDestroyLambdaRequest) (*TaskResponse, *http.Response, error) {
+func (a *LambdaApiService) StartLambdaExecute(r ApiStartLambdaRequest) (*TaskResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod   = http.MethodPost
 		localVarPostBody     interface{}
@@ -174,12 +1952,12 @@ func (a *LambdaApiService) DestroyLambdaExecute(r ApiDestroyLambdaRequest) (*Tas
 		localVarReturnValue  *TaskResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.DestroyLambda")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.StartLambda")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/lambda/{id}/destroy"
+	localVarPath := localBasePath + "/lambda/{id}/start"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterToString(r.id, "")), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -247,25 +2025,25 @@ func (a *LambdaApiService) DestroyLambdaExecute(r ApiDestroyLambdaRequest) (*Tas
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiGetLambdaRequest struct {
+type ApiStopLambdaRequest struct {
 	ctx context.Context
 	ApiService *LambdaApiService
 	id string
 }
 
-func (r ApiGetLambdaRequest) Execute() (*Lambda, *http.Response, error) {
-	return r.ApiService.GetLambdaExecute(r)
+func (r ApiStopLambdaRequest) Execute() (*TaskResponse, *http.Response, error) {
+	return r.ApiService.StopLambdaExecute(r)
 }
 
 /*
-GetLambda Get lambda
+StopLambda Stop lambda container keeping its image
 
  @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
  @param id lambda id
- @return ApiGetLambdaRequest
+ @return ApiStopLambdaRequest
 */
-func (a *LambdaApiService) GetLambda(ctx context.Context, id string) ApiGetLambdaRequest {
-	return ApiGetLambdaRequest{
+func (a *LambdaApiService) StopLambda(ctx context.Context, id string) ApiStopLambdaRequest {
+	return ApiStopLambdaRequest{
 		ApiService: a,
 		ctx: ctx,
 		id: id,
@@ -273,21 +2051,21 @@ func (a *LambdaApiService) GetLambda(ctx context.Context, id string) ApiGetLambd
 }
 
 // Execute executes the request
-//  @return Lambda
-func (a *LambdaApiService) GetLambdaExecute(r ApiGetLambdaRequest) (*Lambda, *http.Response, error) {
+//  @return TaskResponse
+func (a *LambdaApiService) StopLambdaExecute(r ApiStopLambdaRequest) (*TaskResponse, *http.Response, error) {
 	var (
-		localVarHTTPMethod   = http.MethodGet
+		localVarHTTPMethod   = http.MethodPost
 		localVarPostBody     interface{}
 		formFiles            []formFile
-		localVarReturnValue  *Lambda
+		localVarReturnValue  *TaskResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.GetLambda")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.StopLambda")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/lambda/{id}"
+	localVarPath := localBasePath + "/lambda/{id}/stop"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterToString(r.id, "")), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -355,51 +2133,65 @@ func (a *LambdaApiService) GetLambdaExecute(r ApiGetLambdaRequest) (*Lambda, *ht
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiListLambdasRequest struct {
+type ApiUpdateLambdaCodeRequest struct {
 	ctx context.Context
 	ApiService *LambdaApiService
+	id string
+	updateLambdaCode *UpdateLambdaCode
 }
 
-func (r ApiListLambdasRequest) Execute() ([]Lambda, *http.Response, error) {
-	return r.ApiService.ListLambdasExecute(r)
+// Update lambda code body
+func (r ApiUpdateLambdaCodeRequest) UpdateLambdaCode(updateLambdaCode UpdateLambdaCode) ApiUpdateLambdaCodeRequest {
+	r.updateLambdaCode = &updateLambdaCode
+	return r
+}
+
+func (r ApiUpdateLambdaCodeRequest) Execute() (*TaskResponse, *http.Response, error) {
+	return r.ApiService.UpdateLambdaCodeExecute(r)
 }
 
 /*
-ListLambdas List lambdas
+UpdateLambdaCode Update lambda code and replace running container
 
  @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
- @return ApiListLambdasRequest
+ @param id lambda id
+ @return ApiUpdateLambdaCodeRequest
 */
-func (a *LambdaApiService) ListLambdas(ctx context.Context) ApiListLambdasRequest {
-	return ApiListLambdasRequest{
+func (a *LambdaApiService) UpdateLambdaCode(ctx context.Context, id string) ApiUpdateLambdaCodeRequest {
+	return ApiUpdateLambdaCodeRequest{
 		ApiService: a,
 		ctx: ctx,
+		id: id,
 	}
 }
 
 // Execute executes the request
-//  @return []Lambda
-func (a *LambdaApiService) ListLambdasExecute(r ApiListLambdasRequest) ([]Lambda, *http.Response, error) {
+//  @return TaskResponse
+func (a *LambdaApiService) UpdateLambdaCodeExecute(r ApiUpdateLambdaCodeRequest) (*TaskResponse, *http.Response, error) {
 	var (
-		localVarHTTPMethod   = http.MethodGet
+		localVarHTTPMethod   = http.MethodPut
 		localVarPostBody     interface{}
 		formFiles            []formFile
-		localVarReturnValue  []Lambda
+		localVarReturnValue  *TaskResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.ListLambdas")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.UpdateLambdaCode")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/lambda"
+	localVarPath := localBasePath + "/lambda/{id}/code"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterToString(r.id, "")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.updateLambdaCode == nil {
+		return localVarReturnValue, nil, reportError("updateLambdaCode is required and must be specified")
+	}
 
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{}
+	localVarHTTPContentTypes := []string{"application/json"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -415,6 +2207,8 @@ func (a *LambdaApiService) ListLambdasExecute(r ApiListLambdasRequest) ([]Lambda
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	// body params
+	localVarPostBody = r.updateLambdaCode
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -459,25 +2253,25 @@ func (a *LambdaApiService) ListLambdasExecute(r ApiListLambdasRequest) ([]Lambda
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiStartLambdaRequest struct {
+type ApiWakeLambdaRequest struct {
 	ctx context.Context
 	ApiService *LambdaApiService
 	id string
 }
 
-func (r ApiStartLambdaRequest) Execute() (*TaskResponse, *http.Response, error) {
-	return r.ApiService.StartLambdaExecute(r)
+func (r ApiWakeLambdaRequest) Execute() (*TaskResponse, *http.Response, error) {
+	return r.ApiService.WakeLambdaExecute(r)
 }
 
 /*
-StartLambda Start lambda
+WakeLambda Start idle lambda containers and wait until they are healthy
 
  @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
  @param id lambda id
- @return ApiStartLambdaRequest
+ @return ApiWakeLambdaRequest
 */
-func (a *LambdaApiService) StartLambda(ctx context.Context, id string) ApiStartLambdaRequest {
-	return ApiStartLambdaRequest{
+func (a *LambdaApiService) WakeLambda(ctx context.Context, id string) ApiWakeLambdaRequest {
+	return ApiWakeLambdaRequest{
 		ApiService: a,
 		ctx: ctx,
 		id: id,
@@ -486,7 +2280,7 @@ func (a *LambdaApiService) StartLambda(ctx context.Context, id string) ApiStartL
 
 // Execute executes the request
 //  @return TaskResponse
-func (a *LambdaApiService) StartLambdaExecute(r ApiStartLambdaRequest) (*TaskResponse, *http.Response, error) {
+func (a *LambdaApiService) WakeLambdaExecute(r ApiWakeLambdaRequest) (*TaskResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod   = http.MethodPost
 		localVarPostBody     interface{}
@@ -494,12 +2288,12 @@ func (a *LambdaApiService) StartLambdaExecute(r ApiStartLambdaRequest) (*TaskRes
 		localVarReturnValue  *TaskResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.StartLambda")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "LambdaApiService.WakeLambda")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/lambda/{id}/start"
+	localVarPath := localBasePath + "/lambda/{id}/wake"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterToString(r.id, "")), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -49,6 +49,8 @@ type APIClient struct {
 
 	// API Services
 
+	BlobApi *BlobApiService
+
 	EndpointApi *EndpointApiService
 
 	LambdaApi *LambdaApiService
@@ -76,6 +78,7 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.common.client = c
 
 	// API Services
+	c.BlobApi = (*BlobApiService)(&c.common)
 	c.EndpointApi = (*EndpointApiService)(&c.common)
 	c.LambdaApi = (*LambdaApiService)(&c.common)
 	c.RuntimeApi = (*RuntimeApiService)(&c.common)
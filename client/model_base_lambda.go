@@ -19,6 +19,15 @@ type BaseLambda struct {
 	Name string `json:"name"`
 	Runtime string `json:"runtime"`
 	LambdaType string `json:"lambda_type"`
+	Resources *LambdaResources `json:"resources,omitempty"`
+	// number of lambda containers, 1 by default
+	Replicas *int64 `json:"replicas,omitempty"`
+	// seconds without requests after which lambda containers are stopped, 0 disables it
+	IdleTimeout *int64 `json:"idle_timeout,omitempty"`
+	Autoscaling *LambdaAutoscaling `json:"autoscaling,omitempty"`
+	RestartPolicy *LambdaRestartPolicy `json:"restart_policy,omitempty"`
+	// values of the runtime parameters
+	BuildArgs []LambdaBuildArg `json:"build_args,omitempty"`
 }
 
 // NewBaseLambda instantiates a new BaseLambda object
@@ -113,6 +122,198 @@ func (o *BaseLambda) SetLambdaType(v string) {
 	o.LambdaType = v
 }
 
+// GetResources returns the Resources field value if set, zero value otherwise.
+func (o *BaseLambda) GetResources() LambdaResources {
+	if o == nil || o.Resources == nil {
+		var ret LambdaResources
+		return ret
+	}
+	return *o.Resources
+}
+
+// GetResourcesOk returns a tuple with the Resources field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BaseLambda) GetResourcesOk() (*LambdaResources, bool) {
+	if o == nil || o.Resources == nil {
+		return nil, false
+	}
+	return o.Resources, true
+}
+
+// HasResources returns a boolean if a field has been set.
+func (o *BaseLambda) HasResources() bool {
+	if o != nil && o.Resources != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetResources gets a reference to the given LambdaResources and assigns it to the Resources field.
+func (o *BaseLambda) SetResources(v LambdaResources) {
+	o.Resources = &v
+}
+
+// GetReplicas returns the Replicas field value if set, zero value otherwise.
+func (o *BaseLambda) GetReplicas() int64 {
+	if o == nil || o.Replicas == nil {
+		var ret int64
+		return ret
+	}
+	return *o.Replicas
+}
+
+// GetReplicasOk returns a tuple with the Replicas field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BaseLambda) GetReplicasOk() (*int64, bool) {
+	if o == nil || o.Replicas == nil {
+		return nil, false
+	}
+	return o.Replicas, true
+}
+
+// HasReplicas returns a boolean if a field has been set.
+func (o *BaseLambda) HasReplicas() bool {
+	if o != nil && o.Replicas != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetReplicas gets a reference to the given int64 and assigns it to the Replicas field.
+func (o *BaseLambda) SetReplicas(v int64) {
+	o.Replicas = &v
+}
+
+// GetIdleTimeout returns the IdleTimeout field value if set, zero value otherwise.
+func (o *BaseLambda) GetIdleTimeout() int64 {
+	if o == nil || o.IdleTimeout == nil {
+		var ret int64
+		return ret
+	}
+	return *o.IdleTimeout
+}
+
+// GetIdleTimeoutOk returns a tuple with the IdleTimeout field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BaseLambda) GetIdleTimeoutOk() (*int64, bool) {
+	if o == nil || o.IdleTimeout == nil {
+		return nil, false
+	}
+	return o.IdleTimeout, true
+}
+
+// HasIdleTimeout returns a boolean if a field has been set.
+func (o *BaseLambda) HasIdleTimeout() bool {
+	if o != nil && o.IdleTimeout != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetIdleTimeout gets a reference to the given int64 and assigns it to the IdleTimeout field.
+func (o *BaseLambda) SetIdleTimeout(v int64) {
+	o.IdleTimeout = &v
+}
+
+// GetAutoscaling returns the Autoscaling field value if set, zero value otherwise.
+func (o *BaseLambda) GetAutoscaling() LambdaAutoscaling {
+	if o == nil || o.Autoscaling == nil {
+		var ret LambdaAutoscaling
+		return ret
+	}
+	return *o.Autoscaling
+}
+
+// GetAutoscalingOk returns a tuple with the Autoscaling field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BaseLambda) GetAutoscalingOk() (*LambdaAutoscaling, bool) {
+	if o == nil || o.Autoscaling == nil {
+		return nil, false
+	}
+	return o.Autoscaling, true
+}
+
+// HasAutoscaling returns a boolean if a field has been set.
+func (o *BaseLambda) HasAutoscaling() bool {
+	if o != nil && o.Autoscaling != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAutoscaling gets a reference to the given LambdaAutoscaling and assigns it to the Autoscaling field.
+func (o *BaseLambda) SetAutoscaling(v LambdaAutoscaling) {
+	o.Autoscaling = &v
+}
+
+// GetRestartPolicy returns the RestartPolicy field value if set, zero value otherwise.
+func (o *BaseLambda) GetRestartPolicy() LambdaRestartPolicy {
+	if o == nil || o.RestartPolicy == nil {
+		var ret LambdaRestartPolicy
+		return ret
+	}
+	return *o.RestartPolicy
+}
+
+// GetRestartPolicyOk returns a tuple with the RestartPolicy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BaseLambda) GetRestartPolicyOk() (*LambdaRestartPolicy, bool) {
+	if o == nil || o.RestartPolicy == nil {
+		return nil, false
+	}
+	return o.RestartPolicy, true
+}
+
+// HasRestartPolicy returns a boolean if a field has been set.
+func (o *BaseLambda) HasRestartPolicy() bool {
+	if o != nil && o.RestartPolicy != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetRestartPolicy gets a reference to the given LambdaRestartPolicy and assigns it to the RestartPolicy field.
+func (o *BaseLambda) SetRestartPolicy(v LambdaRestartPolicy) {
+	o.RestartPolicy = &v
+}
+
+// GetBuildArgs returns the BuildArgs field value if set, zero value otherwise.
+func (o *BaseLambda) GetBuildArgs() []LambdaBuildArg {
+	if o == nil || o.BuildArgs == nil {
+		var ret []LambdaBuildArg
+		return ret
+	}
+	return o.BuildArgs
+}
+
+// GetBuildArgsOk returns a tuple with the BuildArgs field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BaseLambda) GetBuildArgsOk() ([]LambdaBuildArg, bool) {
+	if o == nil || o.BuildArgs == nil {
+		return nil, false
+	}
+	return o.BuildArgs, true
+}
+
+// HasBuildArgs returns a boolean if a field has been set.
+func (o *BaseLambda) HasBuildArgs() bool {
+	if o != nil && o.BuildArgs != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetBuildArgs gets a reference to the given []LambdaBuildArg and assigns it to the BuildArgs field.
+func (o *BaseLambda) SetBuildArgs(v []LambdaBuildArg) {
+	o.BuildArgs = v
+}
+
 func (o BaseLambda) MarshalJSON() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
 	if true {
@@ -124,6 +325,24 @@ func (o BaseLambda) MarshalJSON() ([]byte, error) {
 	if true {
 		toSerialize["lambda_type"] = o.LambdaType
 	}
+	if o.Resources != nil {
+		toSerialize["resources"] = o.Resources
+	}
+	if o.Replicas != nil {
+		toSerialize["replicas"] = o.Replicas
+	}
+	if o.IdleTimeout != nil {
+		toSerialize["idle_timeout"] = o.IdleTimeout
+	}
+	if o.Autoscaling != nil {
+		toSerialize["autoscaling"] = o.Autoscaling
+	}
+	if o.RestartPolicy != nil {
+		toSerialize["restart_policy"] = o.RestartPolicy
+	}
+	if o.BuildArgs != nil {
+		toSerialize["build_args"] = o.BuildArgs
+	}
 	return json.Marshal(toSerialize)
 }
 
@@ -17,6 +17,8 @@ import (
 // BaseRuntime struct for BaseRuntime
 type BaseRuntime struct {
 	Name string `json:"name"`
+	Healthcheck *RuntimeHealthcheck `json:"healthcheck,omitempty"`
+	Parameters []RuntimeParameter `json:"parameters,omitempty"`
 }
 
 // NewBaseRuntime instantiates a new BaseRuntime object
@@ -61,11 +63,81 @@ func (o *BaseRuntime) SetName(v string) {
 	o.Name = v
 }
 
+// GetHealthcheck returns the Healthcheck field value if set, zero value otherwise.
+func (o *BaseRuntime) GetHealthcheck() RuntimeHealthcheck {
+	if o == nil || o.Healthcheck == nil {
+		var ret RuntimeHealthcheck
+		return ret
+	}
+	return *o.Healthcheck
+}
+
+// GetHealthcheckOk returns a tuple with the Healthcheck field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BaseRuntime) GetHealthcheckOk() (*RuntimeHealthcheck, bool) {
+	if o == nil || o.Healthcheck == nil {
+		return nil, false
+	}
+	return o.Healthcheck, true
+}
+
+// HasHealthcheck returns a boolean if a field has been set.
+func (o *BaseRuntime) HasHealthcheck() bool {
+	if o != nil && o.Healthcheck != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetHealthcheck gets a reference to the given RuntimeHealthcheck and assigns it to the Healthcheck field.
+func (o *BaseRuntime) SetHealthcheck(v RuntimeHealthcheck) {
+	o.Healthcheck = &v
+}
+
+// GetParameters returns the Parameters field value if set, zero value otherwise.
+func (o *BaseRuntime) GetParameters() []RuntimeParameter {
+	if o == nil || o.Parameters == nil {
+		var ret []RuntimeParameter
+		return ret
+	}
+	return o.Parameters
+}
+
+// GetParametersOk returns a tuple with the Parameters field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BaseRuntime) GetParametersOk() ([]RuntimeParameter, bool) {
+	if o == nil || o.Parameters == nil {
+		return nil, false
+	}
+	return o.Parameters, true
+}
+
+// HasParameters returns a boolean if a field has been set.
+func (o *BaseRuntime) HasParameters() bool {
+	if o != nil && o.Parameters != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetParameters gets a reference to the given []RuntimeParameter and assigns it to the Parameters field.
+func (o *BaseRuntime) SetParameters(v []RuntimeParameter) {
+	o.Parameters = v
+}
+
 func (o BaseRuntime) MarshalJSON() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
 	if true {
 		toSerialize["name"] = o.Name
 	}
+	if o.Healthcheck != nil {
+		toSerialize["healthcheck"] = o.Healthcheck
+	}
+	if o.Parameters != nil {
+		toSerialize["parameters"] = o.Parameters
+	}
 	return json.Marshal(toSerialize)
 }
 
@@ -19,6 +19,7 @@ type CreateEndpoint struct {
 	Name string `json:"name"`
 	Path string `json:"path"`
 	Lambda string `json:"lambda"`
+	Alias *string `json:"alias,omitempty"`
 }
 
 // NewCreateEndpoint instantiates a new CreateEndpoint object
@@ -113,6 +114,38 @@ func (o *CreateEndpoint) SetLambda(v string) {
 	o.Lambda = v
 }
 
+// GetAlias returns the Alias field value if set, zero value otherwise.
+func (o *CreateEndpoint) GetAlias() string {
+	if o == nil || o.Alias == nil {
+		var ret string
+		return ret
+	}
+	return *o.Alias
+}
+
+// GetAliasOk returns a tuple with the Alias field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateEndpoint) GetAliasOk() (*string, bool) {
+	if o == nil || o.Alias == nil {
+		return nil, false
+	}
+	return o.Alias, true
+}
+
+// HasAlias returns a boolean if a field has been set.
+func (o *CreateEndpoint) HasAlias() bool {
+	if o != nil && o.Alias != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAlias gets a reference to the given string and assigns it to the Alias field.
+func (o *CreateEndpoint) SetAlias(v string) {
+	o.Alias = &v
+}
+
 func (o CreateEndpoint) MarshalJSON() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
 	if true {
@@ -124,6 +157,9 @@ func (o CreateEndpoint) MarshalJSON() ([]byte, error) {
 	if true {
 		toSerialize["lambda"] = o.Lambda
 	}
+	if o.Alias != nil {
+		toSerialize["alias"] = o.Alias
+	}
 	return json.Marshal(toSerialize)
 }
 
@@ -16,17 +16,29 @@ import (
 
 // CreateLambda struct for CreateLambda
 type CreateLambda struct {
-	Archive string `json:"archive"`
+	// id of the uploaded archive, it is rejected if it exceeds the archive limits or has paths outside of its root
+	Archive *string `json:"archive,omitempty"`
+	// files of the lambda sources made of uploaded blobs, it is passed instead of the archive
+	Manifest []LambdaManifestFile `json:"manifest,omitempty"`
 	Name string `json:"name"`
 	Runtime string `json:"runtime"`
 	LambdaType string `json:"lambda_type"`
+	Resources *LambdaResources `json:"resources,omitempty"`
+	// number of lambda containers, 1 by default
+	Replicas *int64 `json:"replicas,omitempty"`
+	// seconds without requests after which lambda containers are stopped, 0 disables it
+	IdleTimeout *int64 `json:"idle_timeout,omitempty"`
+	Autoscaling *LambdaAutoscaling `json:"autoscaling,omitempty"`
+	RestartPolicy *LambdaRestartPolicy `json:"restart_policy,omitempty"`
+	// values of the runtime parameters
+	BuildArgs []LambdaBuildArg `json:"build_args,omitempty"`
 }
 
 // NewCreateLambda instantiates a new CreateLambda object
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewCreateLambda(archive string, name string, runtime string, lambdaType string) *CreateLambda {
+func NewCreateLambda(name string, runtime string, lambdaType string) *CreateLambda {
 	this := CreateLambda{}
 	this.Name = name
 	this.Runtime = runtime
@@ -42,28 +54,68 @@ func NewCreateLambdaWithDefaults() *CreateLambda {
 	return &this
 }
 
-// GetArchive returns the Archive field value
+// GetArchive returns the Archive field value if set, zero value otherwise.
 func (o *CreateLambda) GetArchive() string {
-	if o == nil {
+	if o == nil || o.Archive == nil {
 		var ret string
 		return ret
 	}
-
-	return o.Archive
+	return *o.Archive
 }
 
-// GetArchiveOk returns a tuple with the Archive field value
+// GetArchiveOk returns a tuple with the Archive field value if set, nil otherwise
 // and a boolean to check if the value has been set.
 func (o *CreateLambda) GetArchiveOk() (*string, bool) {
-	if o == nil {
+	if o == nil || o.Archive == nil {
 		return nil, false
 	}
-	return &o.Archive, true
+	return o.Archive, true
+}
+
+// HasArchive returns a boolean if a field has been set.
+func (o *CreateLambda) HasArchive() bool {
+	if o != nil && o.Archive != nil {
+		return true
+	}
+
+	return false
 }
 
-// SetArchive sets field value
+// SetArchive gets a reference to the given string and assigns it to the Archive field.
 func (o *CreateLambda) SetArchive(v string) {
-	o.Archive = v
+	o.Archive = &v
+}
+
+// GetManifest returns the Manifest field value if set, zero value otherwise.
+func (o *CreateLambda) GetManifest() []LambdaManifestFile {
+	if o == nil || o.Manifest == nil {
+		var ret []LambdaManifestFile
+		return ret
+	}
+	return o.Manifest
+}
+
+// GetManifestOk returns a tuple with the Manifest field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateLambda) GetManifestOk() ([]LambdaManifestFile, bool) {
+	if o == nil || o.Manifest == nil {
+		return nil, false
+	}
+	return o.Manifest, true
+}
+
+// HasManifest returns a boolean if a field has been set.
+func (o *CreateLambda) HasManifest() bool {
+	if o != nil && o.Manifest != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetManifest gets a reference to the given []LambdaManifestFile and assigns it to the Manifest field.
+func (o *CreateLambda) SetManifest(v []LambdaManifestFile) {
+	o.Manifest = v
 }
 
 // GetName returns the Name field value
@@ -138,11 +190,206 @@ func (o *CreateLambda) SetLambdaType(v string) {
 	o.LambdaType = v
 }
 
+// GetResources returns the Resources field value if set, zero value otherwise.
+func (o *CreateLambda) GetResources() LambdaResources {
+	if o == nil || o.Resources == nil {
+		var ret LambdaResources
+		return ret
+	}
+	return *o.Resources
+}
+
+// GetResourcesOk returns a tuple with the Resources field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateLambda) GetResourcesOk() (*LambdaResources, bool) {
+	if o == nil || o.Resources == nil {
+		return nil, false
+	}
+	return o.Resources, true
+}
+
+// HasResources returns a boolean if a field has been set.
+func (o *CreateLambda) HasResources() bool {
+	if o != nil && o.Resources != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetResources gets a reference to the given LambdaResources and assigns it to the Resources field.
+func (o *CreateLambda) SetResources(v LambdaResources) {
+	o.Resources = &v
+}
+
+// GetReplicas returns the Replicas field value if set, zero value otherwise.
+func (o *CreateLambda) GetReplicas() int64 {
+	if o == nil || o.Replicas == nil {
+		var ret int64
+		return ret
+	}
+	return *o.Replicas
+}
+
+// GetReplicasOk returns a tuple with the Replicas field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateLambda) GetReplicasOk() (*int64, bool) {
+	if o == nil || o.Replicas == nil {
+		return nil, false
+	}
+	return o.Replicas, true
+}
+
+// HasReplicas returns a boolean if a field has been set.
+func (o *CreateLambda) HasReplicas() bool {
+	if o != nil && o.Replicas != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetReplicas gets a reference to the given int64 and assigns it to the Replicas field.
+func (o *CreateLambda) SetReplicas(v int64) {
+	o.Replicas = &v
+}
+
+// GetIdleTimeout returns the IdleTimeout field value if set, zero value otherwise.
+func (o *CreateLambda) GetIdleTimeout() int64 {
+	if o == nil || o.IdleTimeout == nil {
+		var ret int64
+		return ret
+	}
+	return *o.IdleTimeout
+}
+
+// GetIdleTimeoutOk returns a tuple with the IdleTimeout field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateLambda) GetIdleTimeoutOk() (*int64, bool) {
+	if o == nil || o.IdleTimeout == nil {
+		return nil, false
+	}
+	return o.IdleTimeout, true
+}
+
+// HasIdleTimeout returns a boolean if a field has been set.
+func (o *CreateLambda) HasIdleTimeout() bool {
+	if o != nil && o.IdleTimeout != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetIdleTimeout gets a reference to the given int64 and assigns it to the IdleTimeout field.
+func (o *CreateLambda) SetIdleTimeout(v int64) {
+	o.IdleTimeout = &v
+}
+
+// GetAutoscaling returns the Autoscaling field value if set, zero value otherwise.
+func (o *CreateLambda) GetAutoscaling() LambdaAutoscaling {
+	if o == nil || o.Autoscaling == nil {
+		var ret LambdaAutoscaling
+		return ret
+	}
+	return *o.Autoscaling
+}
+
+// GetAutoscalingOk returns a tuple with the Autoscaling field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateLambda) GetAutoscalingOk() (*LambdaAutoscaling, bool) {
+	if o == nil || o.Autoscaling == nil {
+		return nil, false
+	}
+	return o.Autoscaling, true
+}
+
+// HasAutoscaling returns a boolean if a field has been set.
+func (o *CreateLambda) HasAutoscaling() bool {
+	if o != nil && o.Autoscaling != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAutoscaling gets a reference to the given LambdaAutoscaling and assigns it to the Autoscaling field.
+func (o *CreateLambda) SetAutoscaling(v LambdaAutoscaling) {
+	o.Autoscaling = &v
+}
+
+// GetRestartPolicy returns the RestartPolicy field value if set, zero value otherwise.
+func (o *CreateLambda) GetRestartPolicy() LambdaRestartPolicy {
+	if o == nil || o.RestartPolicy == nil {
+		var ret LambdaRestartPolicy
+		return ret
+	}
+	return *o.RestartPolicy
+}
+
+// GetRestartPolicyOk returns a tuple with the RestartPolicy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateLambda) GetRestartPolicyOk() (*LambdaRestartPolicy, bool) {
+	if o == nil || o.RestartPolicy == nil {
+		return nil, false
+	}
+	return o.RestartPolicy, true
+}
+
+// HasRestartPolicy returns a boolean if a field has been set.
+func (o *CreateLambda) HasRestartPolicy() bool {
+	if o != nil && o.RestartPolicy != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetRestartPolicy gets a reference to the given LambdaRestartPolicy and assigns it to the RestartPolicy field.
+func (o *CreateLambda) SetRestartPolicy(v LambdaRestartPolicy) {
+	o.RestartPolicy = &v
+}
+
+// GetBuildArgs returns the BuildArgs field value if set, zero value otherwise.
+func (o *CreateLambda) GetBuildArgs() []LambdaBuildArg {
+	if o == nil || o.BuildArgs == nil {
+		var ret []LambdaBuildArg
+		return ret
+	}
+	return o.BuildArgs
+}
+
+// GetBuildArgsOk returns a tuple with the BuildArgs field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateLambda) GetBuildArgsOk() ([]LambdaBuildArg, bool) {
+	if o == nil || o.BuildArgs == nil {
+		return nil, false
+	}
+	return o.BuildArgs, true
+}
+
+// HasBuildArgs returns a boolean if a field has been set.
+func (o *CreateLambda) HasBuildArgs() bool {
+	if o != nil && o.BuildArgs != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetBuildArgs gets a reference to the given []LambdaBuildArg and assigns it to the BuildArgs field.
+func (o *CreateLambda) SetBuildArgs(v []LambdaBuildArg) {
+	o.BuildArgs = v
+}
+
 func (o CreateLambda) MarshalJSON() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
-	if true {
+	if o.Archive != nil {
 		toSerialize["archive"] = o.Archive
 	}
+	if o.Manifest != nil {
+		toSerialize["manifest"] = o.Manifest
+	}
 	if true {
 		toSerialize["name"] = o.Name
 	}
@@ -152,6 +399,24 @@ func (o CreateLambda) MarshalJSON() ([]byte, error) {
 	if true {
 		toSerialize["lambda_type"] = o.LambdaType
 	}
+	if o.Resources != nil {
+		toSerialize["resources"] = o.Resources
+	}
+	if o.Replicas != nil {
+		toSerialize["replicas"] = o.Replicas
+	}
+	if o.IdleTimeout != nil {
+		toSerialize["idle_timeout"] = o.IdleTimeout
+	}
+	if o.Autoscaling != nil {
+		toSerialize["autoscaling"] = o.Autoscaling
+	}
+	if o.RestartPolicy != nil {
+		toSerialize["restart_policy"] = o.RestartPolicy
+	}
+	if o.BuildArgs != nil {
+		toSerialize["build_args"] = o.BuildArgs
+	}
 	return json.Marshal(toSerialize)
 }
 
@@ -17,7 +17,11 @@ import (
 // CreateRuntime struct for CreateRuntime
 type CreateRuntime struct {
 	Dockerfile string `json:"dockerfile"`
+	// uploaded Dockerfile of the image lambda builds derive from, it is passed to them as BASE_IMAGE build arg
+	BaseDockerfile *string `json:"base_dockerfile,omitempty"`
 	Name string `json:"name"`
+	Healthcheck *RuntimeHealthcheck `json:"healthcheck,omitempty"`
+	Parameters []RuntimeParameter `json:"parameters,omitempty"`
 }
 
 // NewCreateRuntime instantiates a new CreateRuntime object
@@ -62,6 +66,38 @@ func (o *CreateRuntime) SetDockerfile(v string) {
 	o.Dockerfile = v
 }
 
+// GetBaseDockerfile returns the BaseDockerfile field value if set, zero value otherwise.
+func (o *CreateRuntime) GetBaseDockerfile() string {
+	if o == nil || o.BaseDockerfile == nil {
+		var ret string
+		return ret
+	}
+	return *o.BaseDockerfile
+}
+
+// GetBaseDockerfileOk returns a tuple with the BaseDockerfile field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateRuntime) GetBaseDockerfileOk() (*string, bool) {
+	if o == nil || o.BaseDockerfile == nil {
+		return nil, false
+	}
+	return o.BaseDockerfile, true
+}
+
+// HasBaseDockerfile returns a boolean if a field has been set.
+func (o *CreateRuntime) HasBaseDockerfile() bool {
+	if o != nil && o.BaseDockerfile != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetBaseDockerfile gets a reference to the given string and assigns it to the BaseDockerfile field.
+func (o *CreateRuntime) SetBaseDockerfile(v string) {
+	o.BaseDockerfile = &v
+}
+
 // GetName returns the Name field value
 func (o *CreateRuntime) GetName() string {
 	if o == nil {
@@ -86,14 +122,87 @@ func (o *CreateRuntime) SetName(v string) {
 	o.Name = v
 }
 
+// GetHealthcheck returns the Healthcheck field value if set, zero value otherwise.
+func (o *CreateRuntime) GetHealthcheck() RuntimeHealthcheck {
+	if o == nil || o.Healthcheck == nil {
+		var ret RuntimeHealthcheck
+		return ret
+	}
+	return *o.Healthcheck
+}
+
+// GetHealthcheckOk returns a tuple with the Healthcheck field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateRuntime) GetHealthcheckOk() (*RuntimeHealthcheck, bool) {
+	if o == nil || o.Healthcheck == nil {
+		return nil, false
+	}
+	return o.Healthcheck, true
+}
+
+// HasHealthcheck returns a boolean if a field has been set.
+func (o *CreateRuntime) HasHealthcheck() bool {
+	if o != nil && o.Healthcheck != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetHealthcheck gets a reference to the given RuntimeHealthcheck and assigns it to the Healthcheck field.
+func (o *CreateRuntime) SetHealthcheck(v RuntimeHealthcheck) {
+	o.Healthcheck = &v
+}
+
+// GetParameters returns the Parameters field value if set, zero value otherwise.
+func (o *CreateRuntime) GetParameters() []RuntimeParameter {
+	if o == nil || o.Parameters == nil {
+		var ret []RuntimeParameter
+		return ret
+	}
+	return o.Parameters
+}
+
+// GetParametersOk returns a tuple with the Parameters field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateRuntime) GetParametersOk() ([]RuntimeParameter, bool) {
+	if o == nil || o.Parameters == nil {
+		return nil, false
+	}
+	return o.Parameters, true
+}
+
+// HasParameters returns a boolean if a field has been set.
+func (o *CreateRuntime) HasParameters() bool {
+	if o != nil && o.Parameters != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetParameters gets a reference to the given []RuntimeParameter and assigns it to the Parameters field.
+func (o *CreateRuntime) SetParameters(v []RuntimeParameter) {
+	o.Parameters = v
+}
+
 func (o CreateRuntime) MarshalJSON() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
 	if true {
 		toSerialize["dockerfile"] = o.Dockerfile
 	}
+	if o.BaseDockerfile != nil {
+		toSerialize["base_dockerfile"] = o.BaseDockerfile
+	}
 	if true {
 		toSerialize["name"] = o.Name
 	}
+	if o.Healthcheck != nil {
+		toSerialize["healthcheck"] = o.Healthcheck
+	}
+	if o.Parameters != nil {
+		toSerialize["parameters"] = o.Parameters
+	}
 	return json.Marshal(toSerialize)
 }
 
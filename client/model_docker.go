@@ -17,9 +17,21 @@ import (
 // Docker struct for Docker
 type Docker struct {
 	Image *string `json:"image,omitempty"`
+	// legacy single container, replaced by replicas
 	Container *string `json:"container,omitempty"`
+	// legacy single container, replaced by replicas
 	ContainerId *string `json:"container_id,omitempty"`
+	Version *int64 `json:"version,omitempty"`
+	// runtime revision the image is built with
+	RuntimeRevision *int64 `json:"runtime_revision,omitempty"`
 	Status string `json:"status"`
+	Replicas []Replica `json:"replicas,omitempty"`
+	// restarts of all lambda containers
+	RestartCount *int64 `json:"restart_count,omitempty"`
+	// exit code of the last exited container
+	ExitCode *int64 `json:"exit_code,omitempty"`
+	// whether the last exited container ran out of memory
+	OomKilled *bool `json:"oom_killed,omitempty"`
 }
 
 // NewDocker instantiates a new Docker object
@@ -136,6 +148,70 @@ func (o *Docker) SetContainerId(v string) {
 	o.ContainerId = &v
 }
 
+// GetVersion returns the Version field value if set, zero value otherwise.
+func (o *Docker) GetVersion() int64 {
+	if o == nil || o.Version == nil {
+		var ret int64
+		return ret
+	}
+	return *o.Version
+}
+
+// GetVersionOk returns a tuple with the Version field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Docker) GetVersionOk() (*int64, bool) {
+	if o == nil || o.Version == nil {
+		return nil, false
+	}
+	return o.Version, true
+}
+
+// HasVersion returns a boolean if a field has been set.
+func (o *Docker) HasVersion() bool {
+	if o != nil && o.Version != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetVersion gets a reference to the given int64 and assigns it to the Version field.
+func (o *Docker) SetVersion(v int64) {
+	o.Version = &v
+}
+
+// GetRuntimeRevision returns the RuntimeRevision field value if set, zero value otherwise.
+func (o *Docker) GetRuntimeRevision() int64 {
+	if o == nil || o.RuntimeRevision == nil {
+		var ret int64
+		return ret
+	}
+	return *o.RuntimeRevision
+}
+
+// GetRuntimeRevisionOk returns a tuple with the RuntimeRevision field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Docker) GetRuntimeRevisionOk() (*int64, bool) {
+	if o == nil || o.RuntimeRevision == nil {
+		return nil, false
+	}
+	return o.RuntimeRevision, true
+}
+
+// HasRuntimeRevision returns a boolean if a field has been set.
+func (o *Docker) HasRuntimeRevision() bool {
+	if o != nil && o.RuntimeRevision != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetRuntimeRevision gets a reference to the given int64 and assigns it to the RuntimeRevision field.
+func (o *Docker) SetRuntimeRevision(v int64) {
+	o.RuntimeRevision = &v
+}
+
 // GetStatus returns the Status field value
 func (o *Docker) GetStatus() string {
 	if o == nil {
@@ -160,6 +236,134 @@ func (o *Docker) SetStatus(v string) {
 	o.Status = v
 }
 
+// GetReplicas returns the Replicas field value if set, zero value otherwise.
+func (o *Docker) GetReplicas() []Replica {
+	if o == nil || o.Replicas == nil {
+		var ret []Replica
+		return ret
+	}
+	return o.Replicas
+}
+
+// GetReplicasOk returns a tuple with the Replicas field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Docker) GetReplicasOk() ([]Replica, bool) {
+	if o == nil || o.Replicas == nil {
+		return nil, false
+	}
+	return o.Replicas, true
+}
+
+// HasReplicas returns a boolean if a field has been set.
+func (o *Docker) HasReplicas() bool {
+	if o != nil && o.Replicas != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetReplicas gets a reference to the given []Replica and assigns it to the Replicas field.
+func (o *Docker) SetReplicas(v []Replica) {
+	o.Replicas = v
+}
+
+// GetRestartCount returns the RestartCount field value if set, zero value otherwise.
+func (o *Docker) GetRestartCount() int64 {
+	if o == nil || o.RestartCount == nil {
+		var ret int64
+		return ret
+	}
+	return *o.RestartCount
+}
+
+// GetRestartCountOk returns a tuple with the RestartCount field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Docker) GetRestartCountOk() (*int64, bool) {
+	if o == nil || o.RestartCount == nil {
+		return nil, false
+	}
+	return o.RestartCount, true
+}
+
+// HasRestartCount returns a boolean if a field has been set.
+func (o *Docker) HasRestartCount() bool {
+	if o != nil && o.RestartCount != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetRestartCount gets a reference to the given int64 and assigns it to the RestartCount field.
+func (o *Docker) SetRestartCount(v int64) {
+	o.RestartCount = &v
+}
+
+// GetExitCode returns the ExitCode field value if set, zero value otherwise.
+func (o *Docker) GetExitCode() int64 {
+	if o == nil || o.ExitCode == nil {
+		var ret int64
+		return ret
+	}
+	return *o.ExitCode
+}
+
+// GetExitCodeOk returns a tuple with the ExitCode field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Docker) GetExitCodeOk() (*int64, bool) {
+	if o == nil || o.ExitCode == nil {
+		return nil, false
+	}
+	return o.ExitCode, true
+}
+
+// HasExitCode returns a boolean if a field has been set.
+func (o *Docker) HasExitCode() bool {
+	if o != nil && o.ExitCode != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetExitCode gets a reference to the given int64 and assigns it to the ExitCode field.
+func (o *Docker) SetExitCode(v int64) {
+	o.ExitCode = &v
+}
+
+// GetOomKilled returns the OomKilled field value if set, zero value otherwise.
+func (o *Docker) GetOomKilled() bool {
+	if o == nil || o.OomKilled == nil {
+		var ret bool
+		return ret
+	}
+	return *o.OomKilled
+}
+
+// GetOomKilledOk returns a tuple with the OomKilled field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Docker) GetOomKilledOk() (*bool, bool) {
+	if o == nil || o.OomKilled == nil {
+		return nil, false
+	}
+	return o.OomKilled, true
+}
+
+// HasOomKilled returns a boolean if a field has been set.
+func (o *Docker) HasOomKilled() bool {
+	if o != nil && o.OomKilled != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetOomKilled gets a reference to the given bool and assigns it to the OomKilled field.
+func (o *Docker) SetOomKilled(v bool) {
+	o.OomKilled = &v
+}
+
 func (o Docker) MarshalJSON() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
 	if o.Image != nil {
@@ -171,9 +375,27 @@ func (o Docker) MarshalJSON() ([]byte, error) {
 	if o.ContainerId != nil {
 		toSerialize["container_id"] = o.ContainerId
 	}
+	if o.Version != nil {
+		toSerialize["version"] = o.Version
+	}
+	if o.RuntimeRevision != nil {
+		toSerialize["runtime_revision"] = o.RuntimeRevision
+	}
 	if true {
 		toSerialize["status"] = o.Status
 	}
+	if o.Replicas != nil {
+		toSerialize["replicas"] = o.Replicas
+	}
+	if o.RestartCount != nil {
+		toSerialize["restart_count"] = o.RestartCount
+	}
+	if o.ExitCode != nil {
+		toSerialize["exit_code"] = o.ExitCode
+	}
+	if o.OomKilled != nil {
+		toSerialize["oom_killed"] = o.OomKilled
+	}
 	return json.Marshal(toSerialize)
 }
 
@@ -22,6 +22,7 @@ type Endpoint struct {
 	UpdatedAt int64 `json:"updated_at"`
 	Path string `json:"path"`
 	Lambda string `json:"lambda"`
+	Alias *string `json:"alias,omitempty"`
 }
 
 // NewEndpoint instantiates a new Endpoint object
@@ -191,6 +192,38 @@ func (o *Endpoint) SetLambda(v string) {
 	o.Lambda = v
 }
 
+// GetAlias returns the Alias field value if set, zero value otherwise.
+func (o *Endpoint) GetAlias() string {
+	if o == nil || o.Alias == nil {
+		var ret string
+		return ret
+	}
+	return *o.Alias
+}
+
+// GetAliasOk returns a tuple with the Alias field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Endpoint) GetAliasOk() (*string, bool) {
+	if o == nil || o.Alias == nil {
+		return nil, false
+	}
+	return o.Alias, true
+}
+
+// HasAlias returns a boolean if a field has been set.
+func (o *Endpoint) HasAlias() bool {
+	if o != nil && o.Alias != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAlias gets a reference to the given string and assigns it to the Alias field.
+func (o *Endpoint) SetAlias(v string) {
+	o.Alias = &v
+}
+
 func (o Endpoint) MarshalJSON() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
 	if true {
@@ -211,6 +244,9 @@ func (o Endpoint) MarshalJSON() ([]byte, error) {
 	if true {
 		toSerialize["lambda"] = o.Lambda
 	}
+	if o.Alias != nil {
+		toSerialize["alias"] = o.Alias
+	}
 	return json.Marshal(toSerialize)
 }
 
@@ -17,19 +17,38 @@ import (
 // Lambda struct for Lambda
 type Lambda struct {
 	Docker Docker `json:"docker"`
+	Version int64 `json:"version"`
+	Versions []LambdaVersion `json:"versions"`
+	Aliases []LambdaAlias `json:"aliases"`
+	Env []LambdaEnvVar `json:"env,omitempty"`
+	ColdStarts *LambdaColdStarts `json:"cold_starts,omitempty"`
+	// lifecycle state of the lambda, unlike docker status it changes only by legal transitions. IDLE lambda is stopped after idle timeout and is woken up on the next request
+	State *string `json:"state,omitempty"`
+	// why the lambda got into its state
+	StatusReason *string `json:"status_reason,omitempty"`
+	StateChangedAt *int64 `json:"state_changed_at,omitempty"`
 	Id string `json:"id"`
 	Name string `json:"name"`
 	CreatedAt int64 `json:"created_at"`
 	UpdatedAt int64 `json:"updated_at"`
 	Runtime string `json:"runtime"`
 	LambdaType string `json:"lambda_type"`
+	Resources *LambdaResources `json:"resources,omitempty"`
+	// number of lambda containers, 1 by default
+	Replicas *int64 `json:"replicas,omitempty"`
+	// seconds without requests after which lambda containers are stopped, 0 disables it
+	IdleTimeout *int64 `json:"idle_timeout,omitempty"`
+	Autoscaling *LambdaAutoscaling `json:"autoscaling,omitempty"`
+	RestartPolicy *LambdaRestartPolicy `json:"restart_policy,omitempty"`
+	// values of the runtime parameters
+	BuildArgs []LambdaBuildArg `json:"build_args,omitempty"`
 }
 
 // NewLambda instantiates a new Lambda object
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewLambda(docker Docker, id string, name string, createdAt int64, updatedAt int64, runtime string, lambdaType string) *Lambda {
+func NewLambda(docker Docker, version int64, versions []LambdaVersion, aliases []LambdaAlias, id string, name string, createdAt int64, updatedAt int64, runtime string, lambdaType string) *Lambda {
 	this := Lambda{}
 	this.Id = id
 	this.Name = name
@@ -72,6 +91,238 @@ func (o *Lambda) SetDocker(v Docker) {
 	o.Docker = v
 }
 
+// GetVersion returns the Version field value
+func (o *Lambda) GetVersion() int64 {
+	if o == nil {
+		var ret int64
+		return ret
+	}
+
+	return o.Version
+}
+
+// GetVersionOk returns a tuple with the Version field value
+// and a boolean to check if the value has been set.
+func (o *Lambda) GetVersionOk() (*int64, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Version, true
+}
+
+// SetVersion sets field value
+func (o *Lambda) SetVersion(v int64) {
+	o.Version = v
+}
+
+// GetVersions returns the Versions field value
+func (o *Lambda) GetVersions() []LambdaVersion {
+	if o == nil {
+		var ret []LambdaVersion
+		return ret
+	}
+
+	return o.Versions
+}
+
+// GetVersionsOk returns a tuple with the Versions field value
+// and a boolean to check if the value has been set.
+func (o *Lambda) GetVersionsOk() ([]LambdaVersion, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return o.Versions, true
+}
+
+// SetVersions sets field value
+func (o *Lambda) SetVersions(v []LambdaVersion) {
+	o.Versions = v
+}
+
+// GetAliases returns the Aliases field value
+func (o *Lambda) GetAliases() []LambdaAlias {
+	if o == nil {
+		var ret []LambdaAlias
+		return ret
+	}
+
+	return o.Aliases
+}
+
+// GetAliasesOk returns a tuple with the Aliases field value
+// and a boolean to check if the value has been set.
+func (o *Lambda) GetAliasesOk() ([]LambdaAlias, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return o.Aliases, true
+}
+
+// SetAliases sets field value
+func (o *Lambda) SetAliases(v []LambdaAlias) {
+	o.Aliases = v
+}
+
+// GetEnv returns the Env field value if set, zero value otherwise.
+func (o *Lambda) GetEnv() []LambdaEnvVar {
+	if o == nil || o.Env == nil {
+		var ret []LambdaEnvVar
+		return ret
+	}
+	return o.Env
+}
+
+// GetEnvOk returns a tuple with the Env field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Lambda) GetEnvOk() ([]LambdaEnvVar, bool) {
+	if o == nil || o.Env == nil {
+		return nil, false
+	}
+	return o.Env, true
+}
+
+// HasEnv returns a boolean if a field has been set.
+func (o *Lambda) HasEnv() bool {
+	if o != nil && o.Env != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetEnv gets a reference to the given []LambdaEnvVar and assigns it to the Env field.
+func (o *Lambda) SetEnv(v []LambdaEnvVar) {
+	o.Env = v
+}
+
+// GetColdStarts returns the ColdStarts field value if set, zero value otherwise.
+func (o *Lambda) GetColdStarts() LambdaColdStarts {
+	if o == nil || o.ColdStarts == nil {
+		var ret LambdaColdStarts
+		return ret
+	}
+	return *o.ColdStarts
+}
+
+// GetColdStartsOk returns a tuple with the ColdStarts field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Lambda) GetColdStartsOk() (*LambdaColdStarts, bool) {
+	if o == nil || o.ColdStarts == nil {
+		return nil, false
+	}
+	return o.ColdStarts, true
+}
+
+// HasColdStarts returns a boolean if a field has been set.
+func (o *Lambda) HasColdStarts() bool {
+	if o != nil && o.ColdStarts != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetColdStarts gets a reference to the given LambdaColdStarts and assigns it to the ColdStarts field.
+func (o *Lambda) SetColdStarts(v LambdaColdStarts) {
+	o.ColdStarts = &v
+}
+
+// GetState returns the State field value if set, zero value otherwise.
+func (o *Lambda) GetState() string {
+	if o == nil || o.State == nil {
+		var ret string
+		return ret
+	}
+	return *o.State
+}
+
+// GetStateOk returns a tuple with the State field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Lambda) GetStateOk() (*string, bool) {
+	if o == nil || o.State == nil {
+		return nil, false
+	}
+	return o.State, true
+}
+
+// HasState returns a boolean if a field has been set.
+func (o *Lambda) HasState() bool {
+	if o != nil && o.State != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetState gets a reference to the given string and assigns it to the State field.
+func (o *Lambda) SetState(v string) {
+	o.State = &v
+}
+
+// GetStatusReason returns the StatusReason field value if set, zero value otherwise.
+func (o *Lambda) GetStatusReason() string {
+	if o == nil || o.StatusReason == nil {
+		var ret string
+		return ret
+	}
+	return *o.StatusReason
+}
+
+// GetStatusReasonOk returns a tuple with the StatusReason field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Lambda) GetStatusReasonOk() (*string, bool) {
+	if o == nil || o.StatusReason == nil {
+		return nil, false
+	}
+	return o.StatusReason, true
+}
+
+// HasStatusReason returns a boolean if a field has been set.
+func (o *Lambda) HasStatusReason() bool {
+	if o != nil && o.StatusReason != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetStatusReason gets a reference to the given string and assigns it to the StatusReason field.
+func (o *Lambda) SetStatusReason(v string) {
+	o.StatusReason = &v
+}
+
+// GetStateChangedAt returns the StateChangedAt field value if set, zero value otherwise.
+func (o *Lambda) GetStateChangedAt() int64 {
+	if o == nil || o.StateChangedAt == nil {
+		var ret int64
+		return ret
+	}
+	return *o.StateChangedAt
+}
+
+// GetStateChangedAtOk returns a tuple with the StateChangedAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Lambda) GetStateChangedAtOk() (*int64, bool) {
+	if o == nil || o.StateChangedAt == nil {
+		return nil, false
+	}
+	return o.StateChangedAt, true
+}
+
+// HasStateChangedAt returns a boolean if a field has been set.
+func (o *Lambda) HasStateChangedAt() bool {
+	if o != nil && o.StateChangedAt != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetStateChangedAt gets a reference to the given int64 and assigns it to the StateChangedAt field.
+func (o *Lambda) SetStateChangedAt(v int64) {
+	o.StateChangedAt = &v
+}
+
 // GetId returns the Id field value
 func (o *Lambda) GetId() string {
 	if o == nil {
@@ -216,11 +467,227 @@ func (o *Lambda) SetLambdaType(v string) {
 	o.LambdaType = v
 }
 
+// GetResources returns the Resources field value if set, zero value otherwise.
+func (o *Lambda) GetResources() LambdaResources {
+	if o == nil || o.Resources == nil {
+		var ret LambdaResources
+		return ret
+	}
+	return *o.Resources
+}
+
+// GetResourcesOk returns a tuple with the Resources field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Lambda) GetResourcesOk() (*LambdaResources, bool) {
+	if o == nil || o.Resources == nil {
+		return nil, false
+	}
+	return o.Resources, true
+}
+
+// HasResources returns a boolean if a field has been set.
+func (o *Lambda) HasResources() bool {
+	if o != nil && o.Resources != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetResources gets a reference to the given LambdaResources and assigns it to the Resources field.
+func (o *Lambda) SetResources(v LambdaResources) {
+	o.Resources = &v
+}
+
+// GetReplicas returns the Replicas field value if set, zero value otherwise.
+func (o *Lambda) GetReplicas() int64 {
+	if o == nil || o.Replicas == nil {
+		var ret int64
+		return ret
+	}
+	return *o.Replicas
+}
+
+// GetReplicasOk returns a tuple with the Replicas field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Lambda) GetReplicasOk() (*int64, bool) {
+	if o == nil || o.Replicas == nil {
+		return nil, false
+	}
+	return o.Replicas, true
+}
+
+// HasReplicas returns a boolean if a field has been set.
+func (o *Lambda) HasReplicas() bool {
+	if o != nil && o.Replicas != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetReplicas gets a reference to the given int64 and assigns it to the Replicas field.
+func (o *Lambda) SetReplicas(v int64) {
+	o.Replicas = &v
+}
+
+// GetIdleTimeout returns the IdleTimeout field value if set, zero value otherwise.
+func (o *Lambda) GetIdleTimeout() int64 {
+	if o == nil || o.IdleTimeout == nil {
+		var ret int64
+		return ret
+	}
+	return *o.IdleTimeout
+}
+
+// GetIdleTimeoutOk returns a tuple with the IdleTimeout field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Lambda) GetIdleTimeoutOk() (*int64, bool) {
+	if o == nil || o.IdleTimeout == nil {
+		return nil, false
+	}
+	return o.IdleTimeout, true
+}
+
+// HasIdleTimeout returns a boolean if a field has been set.
+func (o *Lambda) HasIdleTimeout() bool {
+	if o != nil && o.IdleTimeout != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetIdleTimeout gets a reference to the given int64 and assigns it to the IdleTimeout field.
+func (o *Lambda) SetIdleTimeout(v int64) {
+	o.IdleTimeout = &v
+}
+
+// GetAutoscaling returns the Autoscaling field value if set, zero value otherwise.
+func (o *Lambda) GetAutoscaling() LambdaAutoscaling {
+	if o == nil || o.Autoscaling == nil {
+		var ret LambdaAutoscaling
+		return ret
+	}
+	return *o.Autoscaling
+}
+
+// GetAutoscalingOk returns a tuple with the Autoscaling field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Lambda) GetAutoscalingOk() (*LambdaAutoscaling, bool) {
+	if o == nil || o.Autoscaling == nil {
+		return nil, false
+	}
+	return o.Autoscaling, true
+}
+
+// HasAutoscaling returns a boolean if a field has been set.
+func (o *Lambda) HasAutoscaling() bool {
+	if o != nil && o.Autoscaling != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAutoscaling gets a reference to the given LambdaAutoscaling and assigns it to the Autoscaling field.
+func (o *Lambda) SetAutoscaling(v LambdaAutoscaling) {
+	o.Autoscaling = &v
+}
+
+// GetRestartPolicy returns the RestartPolicy field value if set, zero value otherwise.
+func (o *Lambda) GetRestartPolicy() LambdaRestartPolicy {
+	if o == nil || o.RestartPolicy == nil {
+		var ret LambdaRestartPolicy
+		return ret
+	}
+	return *o.RestartPolicy
+}
+
+// GetRestartPolicyOk returns a tuple with the RestartPolicy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Lambda) GetRestartPolicyOk() (*LambdaRestartPolicy, bool) {
+	if o == nil || o.RestartPolicy == nil {
+		return nil, false
+	}
+	return o.RestartPolicy, true
+}
+
+// HasRestartPolicy returns a boolean if a field has been set.
+func (o *Lambda) HasRestartPolicy() bool {
+	if o != nil && o.RestartPolicy != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetRestartPolicy gets a reference to the given LambdaRestartPolicy and assigns it to the RestartPolicy field.
+func (o *Lambda) SetRestartPolicy(v LambdaRestartPolicy) {
+	o.RestartPolicy = &v
+}
+
+// GetBuildArgs returns the BuildArgs field value if set, zero value otherwise.
+func (o *Lambda) GetBuildArgs() []LambdaBuildArg {
+	if o == nil || o.BuildArgs == nil {
+		var ret []LambdaBuildArg
+		return ret
+	}
+	return o.BuildArgs
+}
+
+// GetBuildArgsOk returns a tuple with the BuildArgs field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Lambda) GetBuildArgsOk() ([]LambdaBuildArg, bool) {
+	if o == nil || o.BuildArgs == nil {
+		return nil, false
+	}
+	return o.BuildArgs, true
+}
+
+// HasBuildArgs returns a boolean if a field has been set.
+func (o *Lambda) HasBuildArgs() bool {
+	if o != nil && o.BuildArgs != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetBuildArgs gets a reference to the given []LambdaBuildArg and assigns it to the BuildArgs field.
+func (o *Lambda) SetBuildArgs(v []LambdaBuildArg) {
+	o.BuildArgs = v
+}
+
 func (o Lambda) MarshalJSON() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
 	if true {
 		toSerialize["docker"] = o.Docker
 	}
+	if true {
+		toSerialize["version"] = o.Version
+	}
+	if true {
+		toSerialize["versions"] = o.Versions
+	}
+	if true {
+		toSerialize["aliases"] = o.Aliases
+	}
+	if o.Env != nil {
+		toSerialize["env"] = o.Env
+	}
+	if o.ColdStarts != nil {
+		toSerialize["cold_starts"] = o.ColdStarts
+	}
+	if o.State != nil {
+		toSerialize["state"] = o.State
+	}
+	if o.StatusReason != nil {
+		toSerialize["status_reason"] = o.StatusReason
+	}
+	if o.StateChangedAt != nil {
+		toSerialize["state_changed_at"] = o.StateChangedAt
+	}
 	if true {
 		toSerialize["id"] = o.Id
 	}
@@ -239,6 +706,24 @@ func (o Lambda) MarshalJSON() ([]byte, error) {
 	if true {
 		toSerialize["lambda_type"] = o.LambdaType
 	}
+	if o.Resources != nil {
+		toSerialize["resources"] = o.Resources
+	}
+	if o.Replicas != nil {
+		toSerialize["replicas"] = o.Replicas
+	}
+	if o.IdleTimeout != nil {
+		toSerialize["idle_timeout"] = o.IdleTimeout
+	}
+	if o.Autoscaling != nil {
+		toSerialize["autoscaling"] = o.Autoscaling
+	}
+	if o.RestartPolicy != nil {
+		toSerialize["restart_policy"] = o.RestartPolicy
+	}
+	if o.BuildArgs != nil {
+		toSerialize["build_args"] = o.BuildArgs
+	}
 	return json.Marshal(toSerialize)
 }
 
@@ -16,17 +16,22 @@ import (
 
 // Runtime struct for Runtime
 type Runtime struct {
+	Revision int64 `json:"revision"`
+	Revisions []RuntimeRevision `json:"revisions"`
+	BaseImage *RuntimeBaseImage `json:"base_image,omitempty"`
 	Id string `json:"id"`
 	Name string `json:"name"`
 	CreatedAt int64 `json:"created_at"`
 	UpdatedAt int64 `json:"updated_at"`
+	Healthcheck *RuntimeHealthcheck `json:"healthcheck,omitempty"`
+	Parameters []RuntimeParameter `json:"parameters,omitempty"`
 }
 
 // NewRuntime instantiates a new Runtime object
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewRuntime(id string, name string, createdAt int64, updatedAt int64) *Runtime {
+func NewRuntime(revision int64, revisions []RuntimeRevision, id string, name string, createdAt int64, updatedAt int64) *Runtime {
 	this := Runtime{}
 	this.Id = id
 	this.Name = name
@@ -43,6 +48,86 @@ func NewRuntimeWithDefaults() *Runtime {
 	return &this
 }
 
+// GetRevision returns the Revision field value
+func (o *Runtime) GetRevision() int64 {
+	if o == nil {
+		var ret int64
+		return ret
+	}
+
+	return o.Revision
+}
+
+// GetRevisionOk returns a tuple with the Revision field value
+// and a boolean to check if the value has been set.
+func (o *Runtime) GetRevisionOk() (*int64, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Revision, true
+}
+
+// SetRevision sets field value
+func (o *Runtime) SetRevision(v int64) {
+	o.Revision = v
+}
+
+// GetRevisions returns the Revisions field value
+func (o *Runtime) GetRevisions() []RuntimeRevision {
+	if o == nil {
+		var ret []RuntimeRevision
+		return ret
+	}
+
+	return o.Revisions
+}
+
+// GetRevisionsOk returns a tuple with the Revisions field value
+// and a boolean to check if the value has been set.
+func (o *Runtime) GetRevisionsOk() ([]RuntimeRevision, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return o.Revisions, true
+}
+
+// SetRevisions sets field value
+func (o *Runtime) SetRevisions(v []RuntimeRevision) {
+	o.Revisions = v
+}
+
+// GetBaseImage returns the BaseImage field value if set, zero value otherwise.
+func (o *Runtime) GetBaseImage() RuntimeBaseImage {
+	if o == nil || o.BaseImage == nil {
+		var ret RuntimeBaseImage
+		return ret
+	}
+	return *o.BaseImage
+}
+
+// GetBaseImageOk returns a tuple with the BaseImage field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Runtime) GetBaseImageOk() (*RuntimeBaseImage, bool) {
+	if o == nil || o.BaseImage == nil {
+		return nil, false
+	}
+	return o.BaseImage, true
+}
+
+// HasBaseImage returns a boolean if a field has been set.
+func (o *Runtime) HasBaseImage() bool {
+	if o != nil && o.BaseImage != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetBaseImage gets a reference to the given RuntimeBaseImage and assigns it to the BaseImage field.
+func (o *Runtime) SetBaseImage(v RuntimeBaseImage) {
+	o.BaseImage = &v
+}
+
 // GetId returns the Id field value
 func (o *Runtime) GetId() string {
 	if o == nil {
@@ -139,8 +224,81 @@ func (o *Runtime) SetUpdatedAt(v int64) {
 	o.UpdatedAt = v
 }
 
+// GetHealthcheck returns the Healthcheck field value if set, zero value otherwise.
+func (o *Runtime) GetHealthcheck() RuntimeHealthcheck {
+	if o == nil || o.Healthcheck == nil {
+		var ret RuntimeHealthcheck
+		return ret
+	}
+	return *o.Healthcheck
+}
+
+// GetHealthcheckOk returns a tuple with the Healthcheck field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Runtime) GetHealthcheckOk() (*RuntimeHealthcheck, bool) {
+	if o == nil || o.Healthcheck == nil {
+		return nil, false
+	}
+	return o.Healthcheck, true
+}
+
+// HasHealthcheck returns a boolean if a field has been set.
+func (o *Runtime) HasHealthcheck() bool {
+	if o != nil && o.Healthcheck != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetHealthcheck gets a reference to the given RuntimeHealthcheck and assigns it to the Healthcheck field.
+func (o *Runtime) SetHealthcheck(v RuntimeHealthcheck) {
+	o.Healthcheck = &v
+}
+
+// GetParameters returns the Parameters field value if set, zero value otherwise.
+func (o *Runtime) GetParameters() []RuntimeParameter {
+	if o == nil || o.Parameters == nil {
+		var ret []RuntimeParameter
+		return ret
+	}
+	return o.Parameters
+}
+
+// GetParametersOk returns a tuple with the Parameters field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Runtime) GetParametersOk() ([]RuntimeParameter, bool) {
+	if o == nil || o.Parameters == nil {
+		return nil, false
+	}
+	return o.Parameters, true
+}
+
+// HasParameters returns a boolean if a field has been set.
+func (o *Runtime) HasParameters() bool {
+	if o != nil && o.Parameters != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetParameters gets a reference to the given []RuntimeParameter and assigns it to the Parameters field.
+func (o *Runtime) SetParameters(v []RuntimeParameter) {
+	o.Parameters = v
+}
+
 func (o Runtime) MarshalJSON() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
+	if true {
+		toSerialize["revision"] = o.Revision
+	}
+	if true {
+		toSerialize["revisions"] = o.Revisions
+	}
+	if o.BaseImage != nil {
+		toSerialize["base_image"] = o.BaseImage
+	}
 	if true {
 		toSerialize["id"] = o.Id
 	}
@@ -153,6 +311,12 @@ func (o Runtime) MarshalJSON() ([]byte, error) {
 	if true {
 		toSerialize["updated_at"] = o.UpdatedAt
 	}
+	if o.Healthcheck != nil {
+		toSerialize["healthcheck"] = o.Healthcheck
+	}
+	if o.Parameters != nil {
+		toSerialize["parameters"] = o.Parameters
+	}
 	return json.Marshal(toSerialize)
 }
 
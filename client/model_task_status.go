@@ -16,10 +16,17 @@ import (
 
 // TaskStatus struct for TaskStatus
 type TaskStatus struct {
+	Id *string `json:"id,omitempty"`
+	// what the task does, e.g. lambda.start
+	Kind *string `json:"kind,omitempty"`
+	// id of the resource the task works on
+	Target *string `json:"target,omitempty"`
 	Status string `json:"status"`
 	StartedAt int64 `json:"started_at"`
 	FinishedAt *int64 `json:"finished_at,omitempty"`
 	Details map[string]interface{} `json:"details,omitempty"`
+	// task output lines, e.g. image build steps
+	Progress []string `json:"progress,omitempty"`
 }
 
 // NewTaskStatus instantiates a new TaskStatus object
@@ -41,6 +48,102 @@ func NewTaskStatusWithDefaults() *TaskStatus {
 	return &this
 }
 
+// GetId returns the Id field value if set, zero value otherwise.
+func (o *TaskStatus) GetId() string {
+	if o == nil || o.Id == nil {
+		var ret string
+		return ret
+	}
+	return *o.Id
+}
+
+// GetIdOk returns a tuple with the Id field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TaskStatus) GetIdOk() (*string, bool) {
+	if o == nil || o.Id == nil {
+		return nil, false
+	}
+	return o.Id, true
+}
+
+// HasId returns a boolean if a field has been set.
+func (o *TaskStatus) HasId() bool {
+	if o != nil && o.Id != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetId gets a reference to the given string and assigns it to the Id field.
+func (o *TaskStatus) SetId(v string) {
+	o.Id = &v
+}
+
+// GetKind returns the Kind field value if set, zero value otherwise.
+func (o *TaskStatus) GetKind() string {
+	if o == nil || o.Kind == nil {
+		var ret string
+		return ret
+	}
+	return *o.Kind
+}
+
+// GetKindOk returns a tuple with the Kind field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TaskStatus) GetKindOk() (*string, bool) {
+	if o == nil || o.Kind == nil {
+		return nil, false
+	}
+	return o.Kind, true
+}
+
+// HasKind returns a boolean if a field has been set.
+func (o *TaskStatus) HasKind() bool {
+	if o != nil && o.Kind != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetKind gets a reference to the given string and assigns it to the Kind field.
+func (o *TaskStatus) SetKind(v string) {
+	o.Kind = &v
+}
+
+// GetTarget returns the Target field value if set, zero value otherwise.
+func (o *TaskStatus) GetTarget() string {
+	if o == nil || o.Target == nil {
+		var ret string
+		return ret
+	}
+	return *o.Target
+}
+
+// GetTargetOk returns a tuple with the Target field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TaskStatus) GetTargetOk() (*string, bool) {
+	if o == nil || o.Target == nil {
+		return nil, false
+	}
+	return o.Target, true
+}
+
+// HasTarget returns a boolean if a field has been set.
+func (o *TaskStatus) HasTarget() bool {
+	if o != nil && o.Target != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetTarget gets a reference to the given string and assigns it to the Target field.
+func (o *TaskStatus) SetTarget(v string) {
+	o.Target = &v
+}
+
 // GetStatus returns the Status field value
 func (o *TaskStatus) GetStatus() string {
 	if o == nil {
@@ -153,8 +256,49 @@ func (o *TaskStatus) SetDetails(v map[string]interface{}) {
 	o.Details = v
 }
 
+// GetProgress returns the Progress field value if set, zero value otherwise.
+func (o *TaskStatus) GetProgress() []string {
+	if o == nil || o.Progress == nil {
+		var ret []string
+		return ret
+	}
+	return o.Progress
+}
+
+// GetProgressOk returns a tuple with the Progress field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TaskStatus) GetProgressOk() ([]string, bool) {
+	if o == nil || o.Progress == nil {
+		return nil, false
+	}
+	return o.Progress, true
+}
+
+// HasProgress returns a boolean if a field has been set.
+func (o *TaskStatus) HasProgress() bool {
+	if o != nil && o.Progress != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetProgress gets a reference to the given []string and assigns it to the Progress field.
+func (o *TaskStatus) SetProgress(v []string) {
+	o.Progress = v
+}
+
 func (o TaskStatus) MarshalJSON() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
+	if o.Id != nil {
+		toSerialize["id"] = o.Id
+	}
+	if o.Kind != nil {
+		toSerialize["kind"] = o.Kind
+	}
+	if o.Target != nil {
+		toSerialize["target"] = o.Target
+	}
 	if true {
 		toSerialize["status"] = o.Status
 	}
@@ -167,6 +311,9 @@ func (o TaskStatus) MarshalJSON() ([]byte, error) {
 	if o.Details != nil {
 		toSerialize["details"] = o.Details
 	}
+	if o.Progress != nil {
+		toSerialize["progress"] = o.Progress
+	}
 	return json.Marshal(toSerialize)
 }
 
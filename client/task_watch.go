@@ -0,0 +1,106 @@
+// Task watch is written by hand, as server-sent events are not supported by the generator.
+
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Statuses of TaskStatus.
+const (
+	TaskPending   = "PENDING"
+	TaskSucceeded = "SUCCEEDED"
+	TaskFailed    = "FAILED"
+	TaskCancelled = "CANCELLED"
+)
+
+// MaxTaskEventSize limits a single server-sent event line, task status events carry the whole task progress.
+const MaxTaskEventSize = 64 * 1024 * 1024
+
+// ErrTaskWatchInterrupted is returned when the task watch ends before the task is finished.
+var ErrTaskWatchInterrupted = errors.New("task watch is interrupted")
+
+// WatchTask waits until the task is finished and returns its final status. Every status pushed by the manager
+// is passed to handle, which may be nil. Each status carries only progress lines which were not sent before.
+func (c *APIClient) WatchTask(ctx context.Context, id string, handle func(status *TaskStatus)) (*TaskStatus, error) {
+	endpoint := fmt.Sprintf("%s/task/%s/watch", c.cfg.Servers[0].URL, url.PathEscape(id))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("task watch failed: %s", resp.Status)
+	}
+
+	var status *TaskStatus
+	err = ReadEvents(resp.Body, func(event string, data string) error {
+		if event != "status" {
+			return nil
+		}
+
+		status = &TaskStatus{}
+		if err := json.Unmarshal([]byte(data), status); err != nil {
+			return err
+		}
+
+		if handle != nil {
+			handle(status)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if status == nil || status.GetStatus() == TaskPending {
+		return nil, ErrTaskWatchInterrupted
+	}
+
+	return status, nil
+}
+
+// ReadEvents passes every server-sent event to handle until the stream ends or handle fails.
+// Events longer than MaxTaskEventSize fail the read.
+func ReadEvents(body io.Reader, handle func(event string, data string) error) error {
+	event := ""
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), MaxTaskEventSize)
+	for scanner.Scan() {
+		text := scanner.Text()
+
+		if strings.HasPrefix(text, "event:") {
+			event = strings.TrimPrefix(text, "event:")
+			continue
+		}
+
+		if !strings.HasPrefix(text, "data:") {
+			continue
+		}
+
+		if err := handle(event, strings.TrimPrefix(text, "data:")); err != nil {
+			return err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read events: %w", err)
+	}
+
+	return nil
+}
@@ -16,7 +16,8 @@ import (
 var rdb *redis.Client
 var DolessID = ""
 
-func init() {
+// Connect connects to redis, it blocks until redis is available.
+func Connect() {
 	redisEndpoint := util.GetStrVar("REDIS_ENDPOINT")
 	rdb = redis.NewClient(&redis.Options{
 		Addr: redisEndpoint,
@@ -31,6 +32,15 @@ func init() {
 	}
 }
 
+func SetValue(ctx context.Context, key string, val interface{}) error {
+	obj, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+
+	return rdb.Set(ctx, key, string(obj), 0).Err()
+}
+
 func scanValues[T any](ctx context.Context, prefix string, handler func(x *T) bool) error {
 	var cursor uint64
 	traversed := map[string]bool{}
@@ -147,7 +157,7 @@ func Subscribe[T any](ctx context.Context, prefix string) <-chan interface{} {
 
 			t := msg.Payload
 			if t == "del" {
-				notificationsC <- &DelNotification{msg.Payload}
+				notificationsC <- &DelNotification{tSlice[1]}
 				continue
 			}
 
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/hedlx/doless/handler/db"
 	"github.com/hedlx/doless/handler/logger"
 	"github.com/hedlx/doless/handler/service"
 	"github.com/hedlx/doless/handler/util"
@@ -14,6 +15,8 @@ import (
 )
 
 func main() {
+	db.Connect()
+
 	svc, err := service.NewService(context.TODO())
 	if err != nil {
 		panic(err)
@@ -28,13 +31,15 @@ func main() {
 		defer req.Body.Close()
 
 		ctx := req.Context()
-		redirect, err := svc.RedirectURL(ctx, req)
+		redirect, release, err := svc.RedirectURL(ctx, req)
 		if err != nil {
 			w.WriteHeader(http.StatusBadRequest)
 			w.Write([]byte(fmt.Sprintf(`{"error":"%s"}`, err.Error())))
 			return
 		}
 
+		defer release()
+
 		redirectURL, err := url.Parse(redirect)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hedlx/doless/handler/db"
+	"github.com/hedlx/doless/handler/logger"
+	"go.uber.org/zap"
+)
+
+// activityInterval limits how often lambda activity is reported, idle timeouts are counted in seconds anyway.
+const activityInterval = 5 * time.Second
+
+// Activity reports time of the last lambda request, so manager can stop lambdas which are idle for too long.
+type Activity struct {
+	lock     *sync.Mutex
+	reported map[string]time.Time
+}
+
+func NewActivity() *Activity {
+	return &Activity{
+		lock:     &sync.Mutex{},
+		reported: map[string]time.Time{},
+	}
+}
+
+func (a *Activity) Touch(ctx context.Context, id string) {
+	now := time.Now()
+
+	a.lock.Lock()
+	if now.Sub(a.reported[id]) < activityInterval {
+		a.lock.Unlock()
+		return
+	}
+
+	a.reported[id] = now
+	a.lock.Unlock()
+
+	if err := db.SetValue(ctx, "activity:"+id, now.UnixMilli()); err != nil {
+		logger.L.Error(
+			"Failed to report lambda activity",
+			zap.Error(err),
+			zap.String("lambda", id),
+		)
+	}
+}
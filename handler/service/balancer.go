@@ -0,0 +1,192 @@
+package service
+
+import (
+	"sync"
+
+	api "github.com/hedlx/doless/client"
+)
+
+type backend struct {
+	address  string
+	inFlight int
+}
+
+// Balancer spreads requests between healthy lambda replicas.
+// It picks the replica with the least in-flight requests, ties are broken in round-robin order.
+type Balancer struct {
+	lock     *sync.Mutex
+	backends map[string][]*backend
+	next     map[string]int
+	hosts    map[string][]string
+	lambdas  map[string]*api.Lambda
+	// undeployed keeps versions of alias hosts which are not run by the lambda containers
+	undeployed map[string]int64
+}
+
+func NewBalancer() *Balancer {
+	return &Balancer{
+		lock:     &sync.Mutex{},
+		backends: map[string][]*backend{},
+		next:     map[string]int{},
+		hosts:    map[string][]string{},
+		lambdas:  map[string]*api.Lambda{},
+
+		undeployed: map[string]int64{},
+	}
+}
+
+// HandleSet replaces backends of all hosts the lambda is reachable by.
+// In-flight counters of replicas which are still healthy are preserved.
+func (b *Balancer) HandleSet(lambda *api.Lambda) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	prev := map[string]map[string]*backend{}
+	for _, host := range b.hosts[lambda.Id] {
+		prev[host] = map[string]*backend{}
+		for _, be := range b.backends[host] {
+			prev[host][be.address] = be
+		}
+	}
+
+	b.remove(lambda.Id)
+
+	hosts := lambdaHosts(lambda)
+	addresses := healthyAddresses(lambda)
+
+	for _, host := range hosts {
+		backends := make([]*backend, 0, len(addresses))
+		for _, address := range addresses {
+			if be, ok := prev[host][address]; ok {
+				backends = append(backends, be)
+				continue
+			}
+
+			backends = append(backends, &backend{address: address})
+		}
+
+		b.backends[host] = backends
+		b.lambdas[host] = lambda
+	}
+
+	for host, version := range undeployedHosts(lambda) {
+		b.undeployed[host] = version
+		hosts = append(hosts, host)
+	}
+
+	b.hosts[lambda.Id] = hosts
+}
+
+func (b *Balancer) HandleDel(id string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.remove(id)
+}
+
+func (b *Balancer) remove(id string) {
+	for _, host := range b.hosts[id] {
+		delete(b.backends, host)
+		delete(b.next, host)
+		delete(b.lambdas, host)
+		delete(b.undeployed, host)
+	}
+
+	delete(b.hosts, id)
+}
+
+// Lambda returns lambda reachable by the host.
+func (b *Balancer) Lambda(host string) *api.Lambda {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.lambdas[host]
+}
+
+// Undeployed returns version of the alias host if the lambda containers don't run it.
+func (b *Balancer) Undeployed(host string) (int64, bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	version, undeployed := b.undeployed[host]
+	return version, undeployed
+}
+
+// Pick returns address of the replica serving the host and a func which must be called once request is done.
+// Empty address is returned if the balancer knows no healthy replica of the host.
+func (b *Balancer) Pick(host string) (string, func()) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	backends := b.backends[host]
+	if len(backends) == 0 {
+		return "", func() {}
+	}
+
+	start := b.next[host] % len(backends)
+	picked := backends[start]
+
+	for i := 1; i < len(backends); i++ {
+		be := backends[(start+i)%len(backends)]
+		if be.inFlight < picked.inFlight {
+			picked = be
+		}
+	}
+
+	b.next[host] = start + 1
+	picked.inFlight++
+
+	var once sync.Once
+
+	return picked.address, func() {
+		once.Do(func() {
+			b.lock.Lock()
+			defer b.lock.Unlock()
+
+			picked.inFlight--
+		})
+	}
+}
+
+// lambdaHosts returns hostnames the lambda is reachable by, mirroring network aliases set by manager.
+func lambdaHosts(lambda *api.Lambda) []string {
+	hosts := []string{lambda.Name}
+
+	if lambda.Docker.Version == nil {
+		return hosts
+	}
+
+	for _, alias := range lambda.Aliases {
+		if alias.Version == *lambda.Docker.Version {
+			hosts = append(hosts, alias.Name+"."+lambda.Name)
+		}
+	}
+
+	return hosts
+}
+
+// undeployedHosts returns hostnames of the aliases pointing to versions other than the deployed one,
+// manager sets no network aliases for them.
+func undeployedHosts(lambda *api.Lambda) map[string]int64 {
+	hosts := map[string]int64{}
+
+	for _, alias := range lambda.Aliases {
+		if lambda.Docker.Version == nil || alias.Version != *lambda.Docker.Version {
+			hosts[alias.Name+"."+lambda.Name] = alias.Version
+		}
+	}
+
+	return hosts
+}
+
+func healthyAddresses(lambda *api.Lambda) []string {
+	addresses := []string{}
+
+	for _, replica := range lambda.Docker.Replicas {
+		if replica.Status == "healthy" || replica.Status == "running" {
+			addresses = append(addresses, replica.Address)
+		}
+	}
+
+	return addresses
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"testing"
+
+	api "github.com/hedlx/doless/client"
+)
+
+// testLambda returns lambda with the deployed version and replicas a, b, c... of the statuses.
+func testLambda(version int64, statuses ...string) *api.Lambda {
+	replicas := []api.Replica{}
+	for i, status := range statuses {
+		address := string(rune('a' + i))
+		replicas = append(replicas, api.Replica{Container: address, ContainerId: address, Address: address, Status: status})
+	}
+
+	return &api.Lambda{
+		Id:      "echo",
+		Name:    "echo",
+		Version: 2,
+		Aliases: []api.LambdaAlias{{Name: "live", Version: 1}, {Name: "canary", Version: 2}},
+		Docker:  api.Docker{Version: &version, Replicas: replicas},
+	}
+}
+
+func TestBalancerLeastInFlight(t *testing.T) {
+	b := NewBalancer()
+	b.HandleSet(testLambda(1, "healthy", "healthy", "healthy"))
+
+	first, releaseFirst := b.Pick("echo")
+	second, _ := b.Pick("echo")
+	third, _ := b.Pick("echo")
+
+	if first == second || second == third || first == third {
+		t.Fatalf("expected requests to be spread between replicas, got %s, %s, %s", first, second, third)
+	}
+
+	releaseFirst()
+
+	if address, _ := b.Pick("echo"); address != first {
+		t.Errorf("expected the only idle replica %s, got %s", first, address)
+	}
+}
+
+func TestBalancerRoundRobinTies(t *testing.T) {
+	b := NewBalancer()
+	b.HandleSet(testLambda(1, "healthy", "healthy", "healthy"))
+
+	picked := []string{}
+	for i := 0; i < 6; i++ {
+		address, release := b.Pick("echo")
+		release()
+		picked = append(picked, address)
+	}
+
+	expected := []string{"a", "b", "c", "a", "b", "c"}
+	for i := range expected {
+		if picked[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected, picked)
+		}
+	}
+}
+
+func TestBalancerReleaseOnce(t *testing.T) {
+	b := NewBalancer()
+	b.HandleSet(testLambda(1, "healthy", "healthy"))
+
+	first, release := b.Pick("echo")
+	release()
+	release()
+
+	// Double release would make the first replica look less busy than the idle one
+	if _, releaseSecond := b.Pick("echo"); releaseSecond == nil {
+		t.Fatal("release is nil")
+	}
+
+	if address, _ := b.Pick("echo"); address != first {
+		t.Errorf("expected %s, got %s", first, address)
+	}
+}
+
+func TestBalancerHealthyReplicas(t *testing.T) {
+	b := NewBalancer()
+	b.HandleSet(testLambda(1, "unhealthy", "running", "reconnecting", "starting"))
+
+	for i := 0; i < 3; i++ {
+		if address, _ := b.Pick("echo"); address != "b" {
+			t.Fatalf("expected the only healthy replica b, got %s", address)
+		}
+	}
+
+	b.HandleSet(testLambda(1, "exited"))
+
+	if address, _ := b.Pick("echo"); address != "" {
+		t.Errorf("expected no replica, got %s", address)
+	}
+}
+
+func TestBalancerKeepsInFlight(t *testing.T) {
+	b := NewBalancer()
+	b.HandleSet(testLambda(1, "healthy", "healthy"))
+
+	busy, _ := b.Pick("echo")
+
+	// Replicas are reported again, e.g. once another lambda field is changed
+	b.HandleSet(testLambda(1, "healthy", "healthy"))
+
+	for i := 0; i < 2; i++ {
+		address, release := b.Pick("echo")
+		if address == busy {
+			t.Fatalf("in-flight request of %s is forgotten", busy)
+		}
+		release()
+	}
+}
+
+func TestBalancerHosts(t *testing.T) {
+	b := NewBalancer()
+	b.HandleSet(testLambda(1, "healthy"))
+
+	for _, host := range []string{"echo", "live.echo"} {
+		if lambda := b.Lambda(host); lambda == nil || lambda.Id != "echo" {
+			t.Errorf("%s: lambda is not found", host)
+		}
+
+		if address, _ := b.Pick(host); address != "a" {
+			t.Errorf("%s: expected a, got %s", host, address)
+		}
+	}
+
+	if version, undeployed := b.Undeployed("canary.echo"); !undeployed || version != 2 {
+		t.Errorf("expected canary.echo to be undeployed version 2, got %d %v", version, undeployed)
+	}
+
+	if address, _ := b.Pick("canary.echo"); address != "" {
+		t.Errorf("undeployed host is balanced to %s", address)
+	}
+
+	// Canary version is rolled out
+	b.HandleSet(testLambda(2, "healthy"))
+
+	if _, undeployed := b.Undeployed("canary.echo"); undeployed {
+		t.Error("deployed host is considered undeployed")
+	}
+
+	if _, undeployed := b.Undeployed("live.echo"); !undeployed {
+		t.Error("live host of the replaced version is considered deployed")
+	}
+
+	b.HandleDel("echo")
+
+	for _, host := range []string{"echo", "live.echo", "canary.echo"} {
+		if b.Lambda(host) != nil {
+			t.Errorf("%s: deleted lambda is found", host)
+		}
+
+		if _, undeployed := b.Undeployed(host); undeployed {
+			t.Errorf("%s: deleted lambda host is kept", host)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"strings"
 
 	api "github.com/hedlx/doless/client"
 	"github.com/hedlx/doless/handler/db"
@@ -11,12 +12,16 @@ func GetEndpoints(ctx context.Context) ([]*api.Endpoint, error) {
 	return db.GetValues[api.Endpoint](ctx, "endpoint")
 }
 
-type NotificationHandler interface {
-	HandleDel(key string)
-	HandleSet(value *api.Endpoint)
+func GetLambdas(ctx context.Context) ([]*api.Lambda, error) {
+	return db.GetValues[api.Lambda](ctx, "lambda")
 }
 
-func SubEndpointChanges(ctx context.Context, handler NotificationHandler) {
+type NotificationHandler[T any] interface {
+	HandleDel(id string)
+	HandleSet(value *T)
+}
+
+func SubEndpointChanges(ctx context.Context, handler NotificationHandler[api.Endpoint]) {
 	notificationsC := db.Subscribe[api.Endpoint](ctx, "endpoint")
 
 	go func() {
@@ -25,7 +30,22 @@ func SubEndpointChanges(ctx context.Context, handler NotificationHandler) {
 			case *db.SetNotification[api.Endpoint]:
 				handler.HandleSet(n.Value)
 			case *db.DelNotification:
-				handler.HandleDel(n.Key)
+				handler.HandleDel(strings.TrimPrefix(n.Key, "endpoint:"))
+			}
+		}
+	}()
+}
+
+func SubLambdaChanges(ctx context.Context, handler NotificationHandler[api.Lambda]) {
+	notificationsC := db.Subscribe[api.Lambda](ctx, "lambda")
+
+	go func() {
+		for notification := range notificationsC {
+			switch n := notification.(type) {
+			case *db.SetNotification[api.Lambda]:
+				handler.HandleSet(n.Value)
+			case *db.DelNotification:
+				handler.HandleDel(strings.TrimPrefix(n.Key, "lambda:"))
 			}
 		}
 	}()
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	api "github.com/hedlx/doless/client"
+	"github.com/hedlx/doless/handler/db"
+	"github.com/hedlx/doless/handler/logger"
+	"go.uber.org/zap"
+)
+
+// metricsInterval is how often lambda metrics are reported to manager autoscaler.
+const metricsInterval = 5 * time.Second
+
+type lambdaMetrics struct {
+	inFlight int64
+	peak     int64
+	requests int64
+	latency  time.Duration
+}
+
+// Metrics gathers lambda concurrency and latency and periodically reports them.
+type Metrics struct {
+	lock    *sync.Mutex
+	lambdas map[string]*lambdaMetrics
+}
+
+func NewMetrics() *Metrics {
+	return &Metrics{
+		lock:    &sync.Mutex{},
+		lambdas: map[string]*lambdaMetrics{},
+	}
+}
+
+// Begin counts new in-flight request to the lambda and returns a func which must be called once it is done.
+func (m *Metrics) Begin(id string) func() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	lm, ok := m.lambdas[id]
+	if !ok {
+		lm = &lambdaMetrics{}
+		m.lambdas[id] = lm
+	}
+
+	lm.inFlight++
+	if lm.inFlight > lm.peak {
+		lm.peak = lm.inFlight
+	}
+
+	began := time.Now()
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			lm.inFlight--
+			lm.requests++
+			lm.latency += time.Since(began)
+		})
+	}
+}
+
+func (m *Metrics) Run(ctx context.Context) {
+	go func() {
+		for {
+			select {
+			case <-time.After(metricsInterval):
+				m.report(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// report publishes metrics gathered since the previous report and starts a new window.
+func (m *Metrics) report(ctx context.Context) {
+	reportedAt := time.Now().UnixMilli()
+	reports := map[string]api.LambdaMetrics{}
+
+	m.lock.Lock()
+	for id, lm := range m.lambdas {
+		report := api.LambdaMetrics{
+			InFlight:     lm.inFlight,
+			PeakInFlight: lm.peak,
+			Requests:     lm.requests,
+			Interval:     metricsInterval.Milliseconds(),
+			ReportedAt:   reportedAt,
+		}
+
+		if lm.requests > 0 {
+			report.AvgLatency = lm.latency.Milliseconds() / lm.requests
+		}
+
+		reports[id] = report
+
+		if lm.inFlight == 0 {
+			// Lambdas without traffic are reported once, manager treats missing reports as no traffic
+			delete(m.lambdas, id)
+			continue
+		}
+
+		lm.peak = lm.inFlight
+		lm.requests = 0
+		lm.latency = 0
+	}
+	m.lock.Unlock()
+
+	for id, report := range reports {
+		if err := db.SetValue(ctx, "metrics:"+id, report); err != nil {
+			logger.L.Error(
+				"Failed to report lambda metrics",
+				zap.Error(err),
+				zap.String("lambda", id),
+			)
+		}
+	}
+}
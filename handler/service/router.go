@@ -33,7 +33,8 @@ func (r Router) Remove(route string) {
 	r.tree.Remove(route)
 }
 
-func (r Router) Get(route string) (string, error) {
+// Get returns URL the route is served by. Resolve maps lambda hostname to the address of its container.
+func (r Router) Get(route string, resolve func(host string) (string, error)) (string, error) {
 	logger.L.Info(
 		"Getting route",
 		zap.String("route", route),
@@ -45,7 +46,12 @@ func (r Router) Get(route string) (string, error) {
 		return "", errors.New("route is not found")
 	}
 
-	prefix := fmt.Sprintf("http://%s:3000", *lambda)
+	address, err := resolve(*lambda)
+	if err != nil {
+		return "", err
+	}
+
+	prefix := fmt.Sprintf("http://%s:3000", address)
 
 	if len(match) == len(route) {
 		return prefix + "/", nil
@@ -2,27 +2,44 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"time"
 
 	api "github.com/hedlx/doless/client"
 	"github.com/hedlx/doless/handler/common"
+	"github.com/hedlx/doless/handler/util"
 	"github.com/samber/lo"
 )
 
 type Service interface {
-	RedirectURL(ctx context.Context, req *http.Request) (string, error)
+	// RedirectURL returns lambda URL for the request and a func releasing the picked replica.
+	RedirectURL(ctx context.Context, req *http.Request) (string, func(), error)
 	Stop()
 }
 
+// IdleState is the state of lambdas stopped by manager after idle timeout, they are woken up on request.
+const IdleState = "IDLE"
+
 type service struct {
 	router    *Router
+	balancer  *Balancer
+	activity  *Activity
+	metrics   *Metrics
+	waker     *Waker
 	endpoints common.ConcurrentMap[string, *api.Endpoint]
 	stop      func()
 }
 
 func NewService(ctx context.Context) (Service, error) {
+	coldStartTimeout := time.Duration(util.GetIntVarDefault("COLD_START_TIMEOUT", 60)) * time.Second
+
 	s := &service{
 		router:    NewRouter(),
+		balancer:  NewBalancer(),
+		activity:  NewActivity(),
+		metrics:   NewMetrics(),
+		waker:     NewWaker(util.GetStrVar("MANAGER_ENDPOINT"), coldStartTimeout),
 		endpoints: common.CreateConcurrentMap[string, *api.Endpoint](),
 	}
 
@@ -41,19 +58,81 @@ func (s *service) init(ctx context.Context) error {
 
 	lo.ForEach(endpoints, func(endpoint *api.Endpoint, _ int) {
 		s.endpoints.Set(endpoint.Id, endpoint)
-		s.router.Add(endpoint.Path, endpoint.Lambda)
+		s.router.Add(endpoint.Path, lambdaHost(endpoint))
+	})
+
+	lambdas, err := GetLambdas(ctx)
+	if err != nil {
+		return err
+	}
+
+	lo.ForEach(lambdas, func(lambda *api.Lambda, _ int) {
+		s.balancer.HandleSet(lambda)
 	})
 
 	cancelCtx, stop := context.WithCancel(context.Background())
 	s.stop = stop
 
 	SubEndpointChanges(cancelCtx, s)
+	SubLambdaChanges(cancelCtx, s.balancer)
+	s.metrics.Run(cancelCtx)
 
 	return nil
 }
 
-func (s service) RedirectURL(ctx context.Context, req *http.Request) (string, error) {
-	return s.router.Get(req.URL.String())
+// lambdaHost returns the hostname of the lambda container serving the endpoint.
+// Manager registers "<alias>.<lambda>" network aliases for every alias of the deployed version.
+func lambdaHost(endpoint *api.Endpoint) string {
+	if endpoint.Alias != nil {
+		return *endpoint.Alias + "." + endpoint.Lambda
+	}
+
+	return endpoint.Lambda
+}
+
+func (s service) RedirectURL(ctx context.Context, req *http.Request) (string, func(), error) {
+	release := func() {}
+
+	redirect, err := s.router.Get(req.URL.String(), func(host string) (string, error) {
+		if version, undeployed := s.balancer.Undeployed(host); undeployed {
+			return "", fmt.Errorf("version %d of %s is not deployed", version, host)
+		}
+
+		lambda := s.balancer.Lambda(host)
+		if lambda != nil {
+			s.activity.Touch(ctx, lambda.Id)
+			release = s.metrics.Begin(lambda.Id)
+
+			if lambda.GetState() == IdleState {
+				wctx, cancel := context.WithTimeout(ctx, s.waker.timeout)
+				defer cancel()
+
+				if err := s.waker.Wake(wctx, lambda.Id); err != nil {
+					return "", err
+				}
+			}
+		}
+
+		address, rel := s.balancer.Pick(host)
+		if address == "" {
+			// Hosts unknown to the balancer are still resolved by docker DNS
+			return host, nil
+		}
+
+		done := release
+		release = func() {
+			rel()
+			done()
+		}
+
+		return address, nil
+	})
+	if err != nil {
+		release()
+		return "", nil, err
+	}
+
+	return redirect, release, nil
 }
 
 func (s service) Stop() {
@@ -67,14 +146,15 @@ func (s service) HandleSet(endpoint *api.Endpoint) {
 	}
 
 	s.endpoints.Set(endpoint.Id, endpoint)
-	s.router.Add(endpoint.Path, endpoint.Lambda)
+	s.router.Add(endpoint.Path, lambdaHost(endpoint))
 }
 
-func (s service) HandleDel(key string) {
-	endpoint := s.endpoints.Get(key, nil)
+func (s service) HandleDel(id string) {
+	endpoint := s.endpoints.Get(id, nil)
 	if endpoint == nil {
 		return
 	}
 
+	s.endpoints.Delete(id)
 	s.router.Remove(endpoint.Path)
 }
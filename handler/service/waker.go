@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	api "github.com/hedlx/doless/client"
+)
+
+type wakeCall struct {
+	done chan struct{}
+	err  error
+}
+
+// Waker asks manager to start idle lambdas. Concurrent requests to the same lambda share a single wake up.
+type Waker struct {
+	client  *api.APIClient
+	timeout time.Duration
+	lock    *sync.Mutex
+	pending map[string]*wakeCall
+}
+
+func NewWaker(managerURL string, timeout time.Duration) *Waker {
+	config := api.NewConfiguration()
+	config.Servers = api.ServerConfigurations{
+		{
+			URL: managerURL,
+		},
+	}
+
+	return &Waker{
+		client:  api.NewAPIClient(config),
+		timeout: timeout,
+		lock:    &sync.Mutex{},
+		pending: map[string]*wakeCall{},
+	}
+}
+
+// Wake blocks until lambda is healthy, cold start timeout expires or ctx is done.
+func (w *Waker) Wake(ctx context.Context, id string) error {
+	w.lock.Lock()
+	call, ok := w.pending[id]
+	if !ok {
+		call = &wakeCall{done: make(chan struct{})}
+		w.pending[id] = call
+
+		go func() {
+			call.err = w.wake(id)
+
+			w.lock.Lock()
+			delete(w.pending, id)
+			w.lock.Unlock()
+
+			close(call.done)
+		}()
+	}
+	w.lock.Unlock()
+
+	select {
+	case <-call.done:
+		return call.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (w *Waker) wake(id string) error {
+	// Wake up is not bound to the request which triggered it
+	ctx, cancel := context.WithTimeout(context.Background(), w.timeout)
+	defer cancel()
+
+	taskResp, _, err := w.client.LambdaApi.WakeLambda(ctx, id).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to wake lambda: %w", err)
+	}
+
+	task, err := w.client.WatchTask(ctx, taskResp.GetTask(), nil)
+	if err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("lambda cold start timed out: %w", ctx.Err())
+		}
+
+		return fmt.Errorf("failed to wake lambda: %w", err)
+	}
+
+	return wakeResult(task)
+}
+
+// wakeResult tells whether the finished wake task has started the lambda, any status but succeeded is a failure.
+func wakeResult(task *api.TaskStatus) error {
+	switch task.GetStatus() {
+	case api.TaskSucceeded:
+		return nil
+	case api.TaskFailed:
+		return fmt.Errorf("failed to wake lambda: %v", task.GetDetails()["error"])
+	default:
+		return fmt.Errorf("failed to wake lambda: task is %s", strings.ToLower(task.GetStatus()))
+	}
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	api "github.com/hedlx/doless/client"
+)
+
+// managerStub serves wake of the lambda, its task watch streams the events.
+func managerStub(t *testing.T, events ...string) (*httptest.Server, *int32) {
+	wakes := new(int32)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/lambda/echo/wake", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		atomic.AddInt32(wakes, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"task":"wake-task"}`)
+	})
+	mux.HandleFunc("/task/wake-task/watch", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, event := range events {
+			fmt.Fprintf(w, "event:status\ndata:%s\n\n", event)
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server, wakes
+}
+
+func TestWake(t *testing.T) {
+	cases := []struct {
+		name   string
+		events []string
+		err    string
+	}{
+		{
+			name:   "succeeded",
+			events: []string{`{"status":"PENDING","started_at":1}`, `{"status":"SUCCEEDED","started_at":1}`},
+		},
+		{
+			name:   "failed",
+			events: []string{`{"status":"FAILED","started_at":1,"details":{"error":"no space left"}}`},
+			err:    "failed to wake lambda: no space left",
+		},
+		{
+			name:   "cancelled",
+			events: []string{`{"status":"CANCELLED","started_at":1}`},
+			err:    "failed to wake lambda: task is cancelled",
+		},
+		{
+			name:   "unknown status",
+			events: []string{`{"status":"SUCCEDED","started_at":1}`},
+			err:    "failed to wake lambda: task is succeded",
+		},
+		{
+			name:   "interrupted while pending",
+			events: []string{`{"status":"PENDING","started_at":1}`},
+			err:    api.ErrTaskWatchInterrupted.Error(),
+		},
+		{
+			name: "interrupted without status",
+			err:  api.ErrTaskWatchInterrupted.Error(),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			server, _ := managerStub(t, c.events...)
+			err := NewWaker(server.URL, time.Second).Wake(context.Background(), "echo")
+
+			if c.err == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("expected error %q, got %v", c.err, err)
+			}
+		})
+	}
+}
+
+func TestWakeIsShared(t *testing.T) {
+	server, wakes := managerStub(t, `{"status":"SUCCEEDED","started_at":1}`)
+	gate := make(chan struct{})
+	w := NewWaker(server.URL, time.Second)
+
+	// Callers join the wake of the first one, it can't finish until the gate is open
+	w.pending["echo"] = &wakeCall{done: gate}
+
+	errs := make(chan error)
+	for i := 0; i < 3; i++ {
+		go func() {
+			errs <- w.Wake(context.Background(), "echo")
+		}()
+	}
+
+	time.Sleep(10 * time.Millisecond)
+
+	w.lock.Lock()
+	delete(w.pending, "echo")
+	w.lock.Unlock()
+	close(gate)
+
+	for i := 0; i < 3; i++ {
+		if err := <-errs; err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+
+	if n := atomic.LoadInt32(wakes); n != 0 {
+		t.Errorf("expected callers to share the pending wake, got %d wakes", n)
+	}
+
+	if err := w.Wake(context.Background(), "echo"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if n := atomic.LoadInt32(wakes); n != 1 {
+		t.Errorf("expected lambda to be woken up once the pending wake is done, got %d wakes", n)
+	}
+}
+
+func TestWakeCancelled(t *testing.T) {
+	block := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-block
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(block) })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := NewWaker(server.URL, time.Second).Wake(ctx, "echo"); err != context.Canceled {
+		t.Errorf("expected cancelled request to stop waiting, got %v", err)
+	}
+}
@@ -0,0 +1,270 @@
+// Package archive extracts uploaded lambda archives within the limits.
+package archive
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/hedlx/doless/manager/util"
+	"github.com/klauspost/compress/zip"
+	"github.com/mholt/archiver/v3"
+	"github.com/nwaples/rardecode"
+)
+
+// Limits of lambda archives, so an upload can't exhaust the manager disk with a zip bomb.
+var (
+	MaxSize           = int64(util.GetIntVarDefault("LAMBDA_ARCHIVE_MAX_SIZE", 64*1024*1024))
+	MaxUnarchivedSize = int64(util.GetIntVarDefault("LAMBDA_ARCHIVE_MAX_UNCOMPRESSED_SIZE", 256*1024*1024))
+	MaxFiles          = util.GetIntVarDefault("LAMBDA_ARCHIVE_MAX_FILES", 10000)
+	MaxPathDepth      = util.GetIntVarDefault("LAMBDA_ARCHIVE_MAX_PATH_DEPTH", 32)
+)
+
+// ErrInvalid is wrapped by errors of archives which are rejected by the limits, contain unsafe paths or can't be read.
+// Errors of writing the extracted files are not wrapped.
+var ErrInvalid = errors.New("invalid archive")
+
+// Invalid returns the error wrapping ErrInvalid.
+func Invalid(format string, a ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalid, fmt.Sprintf(format, a...))
+}
+
+// archiveLink is a symlink which is created once all files are extracted,
+// so no file is written through it.
+type archiveLink struct {
+	entry  string
+	name   string
+	target string
+}
+
+// Unarchive extracts the archive into dest. Unlike archiver.Unarchive, it enforces the archive limits
+// and rejects absolute paths, ".." traversal and symlinks pointing outside of dest.
+func Unarchive(archivePath string, dest string) error {
+	var (
+		files   int
+		size    int64
+		links   []archiveLink
+		walkErr error
+	)
+
+	// archiver formats errors of walkFn into strings, so the error is kept as is to tell invalid archives from failed writes
+	stop := func(err error) error {
+		walkErr = err
+		return archiver.ErrStopWalk
+	}
+
+	err := archiver.Walk(archivePath, func(f archiver.File) error {
+		files++
+		if files > MaxFiles {
+			return stop(Invalid("more than %d files", MaxFiles))
+		}
+
+		name, linkname, err := entryNames(f)
+		if err != nil {
+			return stop(err)
+		}
+
+		if name, err = CleanPath(name); err != nil {
+			return stop(fmt.Errorf("%w: %s", ErrInvalid, err))
+		}
+
+		if name == "" {
+			// Root of the archive itself
+			return nil
+		}
+
+		target := filepath.Join(dest, filepath.FromSlash(name))
+
+		switch {
+		case f.IsDir():
+			if err := os.MkdirAll(target, 0777); err != nil {
+				return stop(err)
+			}
+
+			return nil
+		case f.Mode()&os.ModeSymlink != 0:
+			if path.IsAbs(linkname) || !withinRoot(path.Join(path.Dir(name), linkname)) {
+				return stop(Invalid("symlink %s points outside of the archive", name))
+			}
+
+			links = append(links, archiveLink{entry: name, name: target, target: filepath.FromSlash(linkname)})
+			return nil
+		case !f.Mode().IsRegular():
+			return stop(Invalid("%s is not a regular file", name))
+		}
+
+		written, err := extractFile(f, target, MaxUnarchivedSize-size)
+		if err != nil {
+			return stop(err)
+		}
+
+		size += written
+		if size > MaxUnarchivedSize {
+			return stop(Invalid("uncompressed size exceeds %d bytes", MaxUnarchivedSize))
+		}
+
+		return nil
+	})
+
+	if walkErr != nil {
+		return walkErr
+	}
+
+	if err != nil {
+		// Walk fails by itself only when the archive is of unknown format or can't be decoded
+		return Invalid("%s", err)
+	}
+
+	linked := map[string]bool{}
+	for _, link := range links {
+		linked[link.entry] = true
+	}
+
+	for _, link := range links {
+		// Otherwise the link would be created wherever the parent link points
+		for dir := path.Dir(link.entry); dir != "."; dir = path.Dir(dir) {
+			if linked[dir] {
+				return Invalid("symlink %s is inside of symlink %s", link.entry, dir)
+			}
+		}
+
+		if err := os.MkdirAll(filepath.Dir(link.name), 0777); err != nil {
+			return err
+		}
+
+		if err := os.Symlink(link.target, link.name); err != nil {
+			return Invalid("symlink %s: %s", link.entry, err)
+		}
+	}
+
+	// Links are only checked lexically so far, while a link through another link may still escape
+	for _, link := range links {
+		if err := CheckLink(dest, link.entry, link.name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// entryNames returns path of the archive entry and target of the symlink entry.
+func entryNames(f archiver.File) (string, string, error) {
+	switch h := f.Header.(type) {
+	case *tar.Header:
+		return h.Name, h.Linkname, nil
+	case zip.FileHeader:
+		if f.Mode()&os.ModeSymlink == 0 {
+			return h.Name, "", nil
+		}
+
+		// Zip keeps the symlink target as the file content
+		linkname, err := io.ReadAll(io.LimitReader(entryReader{f}, 4096))
+		return h.Name, string(linkname), err
+	case *rardecode.FileHeader:
+		if f.Mode()&os.ModeSymlink != 0 {
+			return "", "", Invalid("symlink %s is not supported in rar archives", h.Name)
+		}
+
+		return filepath.ToSlash(h.Name), "", nil
+	}
+
+	return "", "", Invalid("unsupported entry %s", f.Name())
+}
+
+// entryReader reads content of the archive entry, failing to decode it makes the archive invalid.
+type entryReader struct {
+	archiver.File
+}
+
+func (r entryReader) Read(p []byte) (int, error) {
+	n, err := r.File.Read(p)
+	if err != nil && err != io.EOF {
+		err = Invalid("%s: %s", r.Name(), err)
+	}
+
+	return n, err
+}
+
+// CleanPath rejects unsafe paths of archive entries and manifest files and returns the path relative to the root.
+func CleanPath(name string) (string, error) {
+	name = strings.ReplaceAll(name, "\\", "/")
+
+	if path.IsAbs(name) || filepath.VolumeName(name) != "" {
+		return "", fmt.Errorf("absolute path %s", name)
+	}
+
+	if !withinRoot(name) {
+		return "", fmt.Errorf("path %s points outside of the root", name)
+	}
+
+	name = strings.TrimPrefix(path.Clean(name), ".")
+	name = strings.TrimPrefix(name, "/")
+
+	if depth := strings.Count(name, "/") + 1; name != "" && depth > MaxPathDepth {
+		return "", fmt.Errorf("path %s is deeper than %d", name, MaxPathDepth)
+	}
+
+	return name, nil
+}
+
+// withinRoot reports whether the relative path doesn't leave the archive root.
+func withinRoot(name string) bool {
+	name = path.Clean(name)
+	return name != ".." && !strings.HasPrefix(name, "../")
+}
+
+// extractFile writes at most limit bytes of the file and returns the number of bytes written,
+// it's greater than limit if the file exceeds it. Header sizes aren't trusted.
+func extractFile(f archiver.File, target string, limit int64) (int64, error) {
+	if err := os.MkdirAll(filepath.Dir(target), 0777); err != nil {
+		return 0, err
+	}
+
+	out, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0666)
+	if err != nil {
+		return 0, err
+	}
+	defer out.Close()
+
+	written, err := io.CopyN(out, entryReader{f}, limit+1)
+	if err == io.EOF {
+		return written, nil
+	}
+
+	return written, err
+}
+
+// CheckLink resolves the symlink extracted into root and checks that it points to a regular file within root.
+// Entry is the path of the symlink in the archive, which is used in errors.
+func CheckLink(root string, entry string, name string) error {
+	resolved, err := filepath.EvalSymlinks(name)
+	if err != nil {
+		return Invalid("symlink %s is broken", entry)
+	}
+
+	realRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return err
+	}
+
+	rel, err := filepath.Rel(realRoot, resolved)
+	if err != nil || !withinRoot(filepath.ToSlash(rel)) {
+		return Invalid("symlink %s points outside of the archive", entry)
+	}
+
+	info, err := os.Stat(resolved)
+	if err != nil {
+		return err
+	}
+
+	if !info.Mode().IsRegular() {
+		return Invalid("symlink %s doesn't point to a regular file", entry)
+	}
+
+	return nil
+}
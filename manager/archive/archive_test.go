@@ -0,0 +1,378 @@
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type entry struct {
+	name string
+	body string
+	link string
+	dir  bool
+}
+
+func writeTar(t *testing.T, file string, entries []entry) {
+	f, err := os.Create(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	w := tar.NewWriter(f)
+	for _, e := range entries {
+		header := &tar.Header{Name: e.name, Mode: 0644, Size: int64(len(e.body)), Typeflag: tar.TypeReg}
+
+		switch {
+		case e.dir:
+			header = &tar.Header{Name: e.name, Mode: 0755, Typeflag: tar.TypeDir}
+		case e.link != "":
+			header = &tar.Header{Name: e.name, Mode: 0777, Linkname: e.link, Typeflag: tar.TypeSymlink}
+		}
+
+		if err := w.WriteHeader(header); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := w.Write([]byte(e.body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func writeZip(t *testing.T, file string, entries []entry) {
+	f, err := os.Create(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	w := zip.NewWriter(f)
+	for _, e := range entries {
+		header := &zip.FileHeader{Name: e.name, Method: zip.Deflate}
+		body := e.body
+
+		switch {
+		case e.dir:
+			header.SetMode(os.ModeDir | 0755)
+		case e.link != "":
+			header.SetMode(os.ModeSymlink | 0777)
+			body = e.link
+		default:
+			header.SetMode(0644)
+		}
+
+		fw, err := w.CreateHeader(header)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := fw.Write([]byte(body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// setLimits overrides the archive limits for the test.
+func setLimits(t *testing.T, size int64, files int, depth int) {
+	prevSize, prevFiles, prevDepth := MaxUnarchivedSize, MaxFiles, MaxPathDepth
+	MaxUnarchivedSize, MaxFiles, MaxPathDepth = size, files, depth
+
+	t.Cleanup(func() {
+		MaxUnarchivedSize, MaxFiles, MaxPathDepth = prevSize, prevFiles, prevDepth
+	})
+}
+
+func TestUnarchive(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		entries []entry
+		limits  func(t *testing.T)
+		invalid bool
+		files   map[string]string
+	}{
+		{
+			name:   "valid tar",
+			format: "tar",
+			entries: []entry{
+				{name: "./", dir: true},
+				{name: "./main.py", body: "print(1)"},
+				{name: "./lib/util.py", body: "x = 1"},
+				{name: "./util.py", link: "lib/util.py"},
+			},
+			files: map[string]string{"main.py": "print(1)", "lib/util.py": "x = 1", "util.py": "x = 1"},
+		},
+		{
+			name:   "valid zip",
+			format: "zip",
+			entries: []entry{
+				{name: "lib/", dir: true},
+				{name: "main.py", body: "print(1)"},
+				{name: "lib/util.py", body: "x = 1"},
+				{name: "util.py", link: "lib/util.py"},
+			},
+			files: map[string]string{"main.py": "print(1)", "lib/util.py": "x = 1", "util.py": "x = 1"},
+		},
+		{
+			name:    "parent traversal",
+			format:  "tar",
+			entries: []entry{{name: "../escaped.py", body: "x"}},
+			invalid: true,
+		},
+		{
+			name:    "nested parent traversal",
+			format:  "zip",
+			entries: []entry{{name: "lib/../../escaped.py", body: "x"}},
+			invalid: true,
+		},
+		{
+			name:    "absolute path",
+			format:  "tar",
+			entries: []entry{{name: "/tmp/escaped.py", body: "x"}},
+			invalid: true,
+		},
+		{
+			name:    "symlink outside of root",
+			format:  "tar",
+			entries: []entry{{name: "passwd", link: "../../etc/passwd"}},
+			invalid: true,
+		},
+		{
+			name:    "absolute symlink",
+			format:  "zip",
+			entries: []entry{{name: "passwd", link: "/etc/passwd"}},
+			invalid: true,
+		},
+		{
+			name:   "file written through symlink",
+			format: "tar",
+			entries: []entry{
+				{name: "lib", link: "."},
+				{name: "lib/main.py", body: "x"},
+			},
+			invalid: true,
+		},
+		{
+			name:    "symlink to directory",
+			format:  "tar",
+			entries: []entry{{name: "lib/", dir: true}, {name: "root", link: "lib"}},
+			invalid: true,
+		},
+		{
+			name:    "broken symlink",
+			format:  "tar",
+			entries: []entry{{name: "main.py", link: "missing.py"}},
+			invalid: true,
+		},
+		{
+			name:    "uncompressed size limit",
+			format:  "zip",
+			entries: []entry{{name: "a.py", body: "1234"}, {name: "b.py", body: "5678"}},
+			limits:  func(t *testing.T) { setLimits(t, 6, MaxFiles, MaxPathDepth) },
+			invalid: true,
+		},
+		{
+			name:    "size within limit",
+			format:  "zip",
+			entries: []entry{{name: "a.py", body: "123"}, {name: "b.py", body: "456"}},
+			limits:  func(t *testing.T) { setLimits(t, 6, MaxFiles, MaxPathDepth) },
+			files:   map[string]string{"a.py": "123", "b.py": "456"},
+		},
+		{
+			name:    "file count limit",
+			format:  "tar",
+			entries: []entry{{name: "a.py"}, {name: "b.py"}, {name: "c.py"}},
+			limits:  func(t *testing.T) { setLimits(t, MaxUnarchivedSize, 2, MaxPathDepth) },
+			invalid: true,
+		},
+		{
+			name:    "path depth limit",
+			format:  "tar",
+			entries: []entry{{name: "a/b/c/d.py"}},
+			limits:  func(t *testing.T) { setLimits(t, MaxUnarchivedSize, MaxFiles, 3) },
+			invalid: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.limits != nil {
+				test.limits(t)
+			}
+
+			dir := t.TempDir()
+			file := filepath.Join(dir, "lambda."+test.format)
+			if test.format == "zip" {
+				writeZip(t, file, test.entries)
+			} else {
+				writeTar(t, file, test.entries)
+			}
+
+			dest := filepath.Join(dir, "extracted")
+			if err := os.Mkdir(dest, 0777); err != nil {
+				t.Fatal(err)
+			}
+
+			err := Unarchive(file, dest)
+			if test.invalid {
+				if !errors.Is(err, ErrInvalid) {
+					t.Fatalf("expected invalid archive error, got %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for name, body := range test.files {
+				content, err := os.ReadFile(filepath.Join(dest, filepath.FromSlash(name)))
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if string(content) != body {
+					t.Errorf("%s: expected %q, got %q", name, body, content)
+				}
+			}
+		})
+	}
+}
+
+func TestUnarchiveUnreadable(t *testing.T) {
+	dir := t.TempDir()
+
+	corrupted := filepath.Join(dir, "corrupted.zip")
+	if err := os.WriteFile(corrupted, []byte("not a zip"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Unarchive(corrupted, dir); !errors.Is(err, ErrInvalid) {
+		t.Errorf("corrupted archive: expected invalid archive error, got %v", err)
+	}
+
+	unknown := filepath.Join(dir, "lambda.txt")
+	if err := os.WriteFile(unknown, []byte("print(1)"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Unarchive(unknown, dir); !errors.Is(err, ErrInvalid) {
+		t.Errorf("unknown format: expected invalid archive error, got %v", err)
+	}
+}
+
+func TestUnarchiveWriteError(t *testing.T) {
+	dir := t.TempDir()
+
+	file := filepath.Join(dir, "lambda.tar")
+	writeTar(t, file, []entry{{name: "main.py", body: "print(1)"}})
+
+	// Destination is a file, so nothing can be extracted into it
+	dest := filepath.Join(dir, "dest")
+	if err := os.WriteFile(dest, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := Unarchive(file, dest)
+	if err == nil {
+		t.Fatal("expected write error")
+	}
+
+	if errors.Is(err, ErrInvalid) {
+		t.Errorf("write error is reported as invalid archive: %v", err)
+	}
+}
+
+func TestCleanPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+		invalid  bool
+	}{
+		{name: "main.py", expected: "main.py"},
+		{name: "./lib/util.py", expected: "lib/util.py"},
+		{name: "lib//./util.py", expected: "lib/util.py"},
+		{name: "lib\\util.py", expected: "lib/util.py"},
+		{name: "lib/../main.py", expected: "main.py"},
+		{name: ".", expected: ""},
+		{name: "./", expected: ""},
+		{name: "..", invalid: true},
+		{name: "../main.py", invalid: true},
+		{name: "lib/../../main.py", invalid: true},
+		{name: "..\\main.py", invalid: true},
+		{name: "/etc/passwd", invalid: true},
+		{name: "\\etc\\passwd", invalid: true},
+	}
+
+	for _, test := range tests {
+		name, err := CleanPath(test.name)
+		if test.invalid {
+			if err == nil {
+				t.Errorf("%s: expected error, got %q", test.name, name)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		} else if name != test.expected {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expected, name)
+		}
+	}
+}
+
+func TestCheckLink(t *testing.T) {
+	dir := t.TempDir()
+	root := filepath.Join(dir, "root")
+
+	for _, d := range []string{root, filepath.Join(root, "lib")} {
+		if err := os.Mkdir(d, 0777); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, f := range []string{filepath.Join(root, "lib", "util.py"), filepath.Join(dir, "secret")} {
+		if err := os.WriteFile(f, []byte("print()"), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		target  string
+		invalid bool
+	}{
+		{name: "file", target: "lib/util.py"},
+		{name: "outside", target: "../secret", invalid: true},
+		{name: "absolute", target: filepath.Join(dir, "secret"), invalid: true},
+		{name: "directory", target: "lib", invalid: true},
+		{name: "broken", target: "missing.py", invalid: true},
+	}
+
+	for _, test := range tests {
+		name := filepath.Join(root, test.name)
+		if err := os.Symlink(filepath.FromSlash(test.target), name); err != nil {
+			t.Fatal(err)
+		}
+
+		err := CheckLink(root, test.name, name)
+		if test.invalid && !errors.Is(err, ErrInvalid) {
+			t.Errorf("%s: expected invalid archive error, got %v", test.name, err)
+		} else if !test.invalid && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+	}
+}
@@ -15,7 +15,8 @@ import (
 var rdb *redis.Client
 var DolessID = ""
 
-func init() {
+// Connect connects to redis and loads id of this manager, it blocks until redis is available.
+func Connect() {
 	redisEndpoint := util.GetStrVar("REDIS_ENDPOINT")
 	rdb = redis.NewClient(&redis.Options{
 		Addr: redisEndpoint,
@@ -55,6 +56,16 @@ func SetValue(ctx context.Context, key string, val interface{}) error {
 	return nil
 }
 
+// SetValueTTL is like SetValue, but the key expires after ttl.
+func SetValueTTL(ctx context.Context, key string, val interface{}, ttl time.Duration) error {
+	obj, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+
+	return rdb.Set(ctx, key, string(obj), ttl).Err()
+}
+
 func scanValues[T any](ctx context.Context, prefix string, handler func(x *T) bool) error {
 	var cursor uint64
 	traversed := map[string]bool{}
@@ -158,3 +169,7 @@ func FindValue[T any](ctx context.Context, prefix string, predicate func(x *T) b
 
 	return res, err
 }
+
+func DelValue(ctx context.Context, key string) error {
+	return rdb.Del(ctx, key).Err()
+}
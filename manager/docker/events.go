@@ -0,0 +1,47 @@
+package docker
+
+import (
+	"context"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+)
+
+// ContainerEvent is a state change of the container created by this manager.
+type ContainerEvent struct {
+	// Container is the container id
+	Container string
+	// Lambda is the id of the lambda the container belongs to
+	Lambda string
+	// Action is docker event action, e.g. "die" or "health_status: healthy"
+	Action string
+}
+
+// Events passes events of the containers created by this manager to handle.
+// It blocks until ctx is done or the subscription fails.
+func (s service) Events(ctx context.Context, handle func(ContainerEvent)) error {
+	msgs, errs := s.client.Events(ctx, types.EventsOptions{
+		Filters: filters.NewArgs(
+			filters.KeyValuePair{Key: "type", Value: events.ContainerEventType},
+			filters.KeyValuePair{Key: "label", Value: "doless=" + s.id},
+		),
+	})
+
+	for {
+		select {
+		case msg := <-msgs:
+			handle(ContainerEvent{
+				Container: msg.Actor.ID,
+				Lambda:    msg.Actor.Attributes[LambdaLabel],
+				Action:    msg.Action,
+			})
+		case err := <-errs:
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return err
+		}
+	}
+}
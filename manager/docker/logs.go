@@ -0,0 +1,99 @@
+package docker
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/pkg/stdcopy"
+)
+
+type LogsOptions struct {
+	// Since is either unix timestamp or duration relative to now
+	Since  string
+	Tail   string
+	Stdout bool
+	Stderr bool
+	Follow bool
+}
+
+type LogLine struct {
+	Stream string
+	Time   time.Time
+	Text   string
+}
+
+// Logs reads container output passing every line to emit. With follow it blocks until ctx is done or container stops.
+func (s service) Logs(ctx context.Context, id string, opts LogsOptions, emit func(LogLine)) error {
+	out, err := s.client.ContainerLogs(ctx, id, types.ContainerLogsOptions{
+		ShowStdout: opts.Stdout,
+		ShowStderr: opts.Stderr,
+		Since:      opts.Since,
+		Tail:       opts.Tail,
+		Follow:     opts.Follow,
+		Timestamps: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	defer out.Close()
+
+	stdout := &lineWriter{stream: "stdout", emit: emit}
+	stderr := &lineWriter{stream: "stderr", emit: emit}
+
+	_, err = stdcopy.StdCopy(stdout, stderr, out)
+
+	stdout.flush()
+	stderr.flush()
+
+	if ctx.Err() != nil {
+		// Follow is interrupted by the caller
+		return nil
+	}
+
+	return err
+}
+
+// lineWriter splits demultiplexed container output into timestamped lines.
+type lineWriter struct {
+	stream string
+	emit   func(LogLine)
+	buf    []byte
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+
+		w.line(string(w.buf[:i]))
+		w.buf = w.buf[i+1:]
+	}
+}
+
+func (w *lineWriter) flush() {
+	if len(w.buf) > 0 {
+		w.line(string(w.buf))
+		w.buf = nil
+	}
+}
+
+func (w *lineWriter) line(raw string) {
+	line := LogLine{Stream: w.stream, Text: raw}
+
+	if ts, text, found := strings.Cut(raw, " "); found {
+		if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			line.Time = t
+			line.Text = text
+		}
+	}
+
+	w.emit(line)
+}
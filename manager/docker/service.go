@@ -15,13 +15,18 @@ import (
 	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/network"
 	"github.com/docker/docker/client"
+	"github.com/docker/go-units"
 	api "github.com/hedlx/doless/client"
 	"github.com/hedlx/doless/manager/logger"
+	"github.com/hedlx/doless/manager/model"
 	"github.com/hedlx/doless/manager/util"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 )
 
+// cpuPeriod is the period CPU quota of lambda resources is measured in, microseconds
+const cpuPeriod = 100000
+
 type service struct {
 	client          *client.Client
 	id              string
@@ -29,13 +34,106 @@ type service struct {
 }
 
 type DockerService interface {
-	Create(ctx context.Context, lambda *api.Lambda, tar io.Reader) (string, error)
-	CreateContainer(ctx context.Context, lambda *api.Lambda) (string, error)
-	Start(ctx context.Context, lambda *api.Lambda) error
-	Stop(ctx context.Context, lambda *api.Lambda) error
+	Build(ctx context.Context, image string, tar io.Reader, args map[string]*string, progress BuildProgress) error
+	CreateContainer(ctx context.Context, image string, name string, opts ContainerOptions, aliases []string) (string, error)
+	WaitHealthy(ctx context.Context, id string) error
+	Start(ctx context.Context, id string) error
+	Stop(ctx context.Context, id string) error
 	ListContainers(ctx context.Context) ([]types.Container, error)
+	ListImages(ctx context.Context) ([]types.ImageSummary, error)
+	ImageExists(ctx context.Context, image string) (bool, error)
 	Inspect(ctx context.Context, id string) (types.ContainerJSON, error)
-	Remove(ctx context.Context, lambda *api.Lambda) error
+	RemoveContainer(ctx context.Context, id string) error
+	RemoveImage(ctx context.Context, image string) error
+	UpdateNetwork(ctx context.Context, id string, aliases []string) error
+	Logs(ctx context.Context, id string, opts LogsOptions, emit func(LogLine)) error
+	Events(ctx context.Context, handle func(ContainerEvent)) error
+	UpdateRestartPolicy(ctx context.Context, id string, policy api.LambdaRestartPolicy) error
+}
+
+// BuildProgress receives image build output line by line.
+type BuildProgress func(line string)
+
+// LambdaLabel is the container label holding id of the lambda the container belongs to.
+const LambdaLabel = "doless.lambda"
+
+// ContainerOptions holds lambda container settings which are not part of the image.
+type ContainerOptions struct {
+	// Env in "NAME=value" form
+	Env       []string
+	Resources api.LambdaResources
+	// Lambda is the id of the lambda the container belongs to
+	Lambda  string
+	Restart api.LambdaRestartPolicy
+	// Healthcheck of the runtime, HEALTHCHECK of the image is used without it
+	Healthcheck *api.RuntimeHealthcheck
+}
+
+// hostConfig applies lambda resource limits to the container.
+func (o ContainerOptions) hostConfig() *container.HostConfig {
+	res := container.Resources{
+		PidsLimit: o.Resources.PidsLimit,
+	}
+
+	if o.Resources.Memory != nil {
+		res.Memory = *o.Resources.Memory
+	}
+
+	if o.Resources.MemorySwap != nil {
+		res.MemorySwap = *o.Resources.MemorySwap
+	}
+
+	if o.Resources.CpuQuota != nil {
+		res.CPUPeriod = cpuPeriod
+		res.CPUQuota = *o.Resources.CpuQuota
+	}
+
+	for _, ulimit := range o.Resources.Ulimits {
+		res.Ulimits = append(res.Ulimits, &units.Ulimit{Name: ulimit.Name, Soft: ulimit.Soft, Hard: ulimit.Hard})
+	}
+
+	return &container.HostConfig{Resources: res, RestartPolicy: restartPolicy(o.Restart)}
+}
+
+// healthcheckScript requests the URL passed as the first argument.
+const healthcheckScript = `curl -fs "$1" > /dev/null || wget -q -O /dev/null "$1" || exit 1`
+
+// healthConfig makes docker healthcheck requesting the runtime health check path of the lambda.
+// Images may lack either curl or wget, so both are tried. URL is passed to the shell as an argument,
+// so it's never parsed as a part of the script.
+func (o ContainerOptions) healthConfig() *container.HealthConfig {
+	if o.Healthcheck == nil {
+		return nil
+	}
+
+	url := "http://localhost:3000" + o.Healthcheck.Path
+	seconds := func(value *int64) time.Duration {
+		if value == nil {
+			return 0
+		}
+
+		return time.Duration(*value) * time.Second
+	}
+
+	return &container.HealthConfig{
+		Test:        []string{"CMD", "sh", "-c", healthcheckScript, "healthcheck", url},
+		Interval:    seconds(o.Healthcheck.Interval),
+		Timeout:     seconds(o.Healthcheck.Timeout),
+		StartPeriod: seconds(o.Healthcheck.StartPeriod),
+		Retries:     int(o.Healthcheck.GetRetries()),
+	}
+}
+
+// restartPolicy maps lambda restart policy to docker one, docker delays restarts with exponential backoff itself.
+func restartPolicy(policy api.LambdaRestartPolicy) container.RestartPolicy {
+	switch policy.Mode {
+	case model.RestartOnFailure:
+		return container.RestartPolicy{Name: "on-failure", MaximumRetryCount: int(policy.GetMaxRetries())}
+	case model.RestartAlways:
+		return container.RestartPolicy{Name: "always"}
+	default:
+		return container.RestartPolicy{Name: "no"}
+	}
 }
 
 func NewDockerService(id string) (DockerService, error) {
@@ -48,8 +146,17 @@ func NewDockerService(id string) (DockerService, error) {
 	return &service{client: client, id: id, internalNetwork: util.GetStrVar("INTERNAL_NETWORK")}, nil
 }
 
+// ListContainers returns all containers created by this manager including stopped ones.
 func (s service) ListContainers(ctx context.Context) ([]types.Container, error) {
 	return s.client.ContainerList(ctx, types.ContainerListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.KeyValuePair{Key: "label", Value: "doless=" + s.id}),
+	})
+}
+
+// ListImages returns images built by this manager.
+func (s service) ListImages(ctx context.Context) ([]types.ImageSummary, error) {
+	return s.client.ImageList(ctx, types.ImageListOptions{
 		Filters: filters.NewArgs(filters.KeyValuePair{Key: "label", Value: "doless=" + s.id}),
 	})
 }
@@ -58,96 +165,207 @@ func (s service) Inspect(ctx context.Context, id string) (types.ContainerJSON, e
 	return s.client.ContainerInspect(ctx, id)
 }
 
-func (s service) Create(ctx context.Context, lambda *api.Lambda, tar io.Reader) (string, error) {
-	if lambda.Docker.Container == nil || lambda.Docker.Image == nil {
-		return "", fmt.Errorf("lambda model is not complete")
+// ImageExists reports whether the image is present locally.
+func (s service) ImageExists(ctx context.Context, image string) (bool, error) {
+	if _, _, err := s.client.ImageInspectWithRaw(ctx, image); err != nil {
+		if client.IsErrNotFound(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// IsNotFound reports whether the error is caused by a missing docker object.
+func IsNotFound(err error) bool {
+	return client.IsErrNotFound(err)
+}
+
+// Build builds the image from the tar context, build steps are passed to progress, which may be nil.
+// Layers are cached by docker, so unchanged steps of the Dockerfile, e.g. dependency downloads, are skipped by the next builds.
+func (s service) Build(ctx context.Context, image string, tar io.Reader, args map[string]*string, progress BuildProgress) error {
+	if progress == nil {
+		progress = func(string) {}
 	}
 
-	images, err := s.client.ImageList(
-		ctx,
-		types.ImageListOptions{
-			Filters: filters.NewArgs(filters.KeyValuePair{Key: "label", Value: "doless=" + s.id}),
-		})
+	images, err := s.ListImages(ctx)
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	_, exists := lo.Find(images, func(image types.ImageSummary) bool {
-		return len(image.RepoTags) > 0 && strings.Split(image.RepoTags[0], ":")[0] == *lambda.Docker.Image
+	_, exists := lo.Find(images, func(summary types.ImageSummary) bool {
+		return lo.Contains(summary.RepoTags, image)
 	})
 	if exists {
-		return "", fmt.Errorf("image already exists: %s", *lambda.Docker.Image)
+		return fmt.Errorf("image already exists: %s", image)
 	}
 
 	out, err := s.client.ImageBuild(ctx, tar, types.ImageBuildOptions{
-		Tags:   []string{*lambda.Docker.Image},
-		Labels: map[string]string{"doless": s.id},
+		Tags:      []string{image},
+		Labels:    map[string]string{"doless": s.id},
+		BuildArgs: args,
+		// Intermediate containers are removed, while intermediate images are kept as build cache
+		Remove: true,
 	})
 	if err != nil {
-		return "", err
+		return err
 	}
 
 	defer out.Body.Close()
 
 	errorMsg := ""
 	scanner := bufio.NewScanner(out.Body)
+	// A single build step may print a long line
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
 	for scanner.Scan() {
 		e := struct {
+			Stream   string `json:"stream"`
+			Status   string `json:"status"`
+			ID       string `json:"id"`
+			Progress string `json:"progress"`
+			Aux      *struct {
+				ID string `json:"ID"`
+			} `json:"aux"`
 			Err *string `json:"error"`
 		}{}
 
 		if err := json.Unmarshal([]byte(scanner.Text()), &e); err != nil {
-			return "", err
+			return err
+		}
+
+		for _, line := range strings.Split(e.Stream, "\n") {
+			if line = strings.TrimRight(line, "\r "); line != "" {
+				progress(line)
+			}
+		}
+
+		// Layer download bars are too noisy, only their final statuses are reported
+		if e.Status != "" && e.Progress == "" {
+			if e.ID != "" {
+				progress(e.ID + ": " + e.Status)
+			} else {
+				progress(e.Status)
+			}
+		}
+
+		if e.Aux != nil && e.Aux.ID != "" {
+			progress("Built image " + e.Aux.ID)
 		}
 
 		if e.Err != nil {
+			progress("Error: " + *e.Err)
 			errorMsg += *e.Err
 		}
 	}
 
+	if err := scanner.Err(); err != nil {
+		// Build output is cut off, so its result is unknown
+		return fmt.Errorf("failed to read build output: %w", err)
+	}
+
 	if errorMsg != "" {
-		return "", fmt.Errorf(errorMsg)
+		return fmt.Errorf(errorMsg)
 	}
 
-	return s.CreateContainer(ctx, lambda)
+	return nil
 }
 
-func (s service) CreateContainer(ctx context.Context, lambda *api.Lambda) (string, error) {
+// CreateContainer creates container connected to the internal network.
+// Besides its name, container is reachable by the given aliases, so container created
+// without aliases doesn't receive lambda traffic until UpdateNetwork is called.
+func (s service) CreateContainer(ctx context.Context, image string, name string, opts ContainerOptions, aliases []string) (string, error) {
 	creator := &ContainerCreator{
-		client: s.client,
-		lambda: lambda,
+		client:  s.client,
+		name:    name,
+		aliases: aliases,
 	}
 
 	err := creator.createContainer(ctx, &container.Config{
-		Image:  *lambda.Docker.Image,
-		Labels: map[string]string{"doless": s.id},
-	})
+		Image:       image,
+		Labels:      map[string]string{"doless": s.id, LambdaLabel: opts.Lambda},
+		Env:         opts.Env,
+		Healthcheck: opts.healthConfig(),
+	}, opts.hostConfig())
 	if err != nil {
 		creator.rollback()
 		return "", err
 	}
 
-	err = creator.setupNetwork(ctx, types.NetworkListOptions{
+	err = creator.setupNetwork(ctx, s.networkListOptions())
+
+	if err != nil {
+		creator.rollback()
+		return "", err
+	}
+
+	return creator.container.ID, nil
+}
+
+func (s service) networkListOptions() types.NetworkListOptions {
+	return types.NetworkListOptions{
 		Filters: filters.NewArgs(filters.KeyValuePair{
 			Key:   "name",
 			Value: s.internalNetwork,
 		}),
-	})
+	}
+}
 
+// UpdateNetwork reconnects container to the internal network to refresh its aliases.
+// Container is unreachable in the internal network until it is connected again.
+func (s service) UpdateNetwork(ctx context.Context, id string, aliases []string) error {
+	net, err := s.findNetwork(ctx)
 	if err != nil {
-		creator.rollback()
-		return "", err
+		return err
 	}
 
-	return creator.container.ID, nil
+	if err := s.client.NetworkDisconnect(ctx, net.ID, id, false); err != nil {
+		return err
+	}
+
+	return s.client.NetworkConnect(ctx, net.ID, id, &network.EndpointSettings{Aliases: aliases})
+}
+
+// UpdateRestartPolicy applies restart policy to the existing container.
+func (s service) UpdateRestartPolicy(ctx context.Context, id string, policy api.LambdaRestartPolicy) error {
+	_, err := s.client.ContainerUpdate(ctx, id, container.UpdateConfig{RestartPolicy: restartPolicy(policy)})
+	return err
+}
+
+func (s service) findNetwork(ctx context.Context) (*types.NetworkResource, error) {
+	nets, err := s.client.NetworkList(ctx, s.networkListOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(nets) != 1 {
+		return nil, errors.New("invalid networks length")
+	}
+
+	return &nets[0], nil
 }
 
-func (s service) Start(ctx context.Context, lambda *api.Lambda) error {
-	if lambda.Docker.ContainerId == nil {
-		return fmt.Errorf("lambda model is not complete")
+// NetworkAliases returns hostnames shared by lambda containers in the internal network:
+// lambda name itself plus "<alias>.<name>" for every alias pointing to the deployed version.
+func NetworkAliases(lambda *api.Lambda) []string {
+	aliases := []string{lambda.Name}
+
+	if lambda.Docker.Version == nil {
+		return aliases
+	}
+
+	for _, alias := range lambda.Aliases {
+		if alias.Version == *lambda.Docker.Version {
+			aliases = append(aliases, alias.Name+"."+lambda.Name)
+		}
 	}
 
-	info, err := s.client.ContainerInspect(ctx, *lambda.Docker.ContainerId)
+	return aliases
+}
+
+func (s service) Start(ctx context.Context, id string) error {
+	info, err := s.client.ContainerInspect(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -156,19 +374,47 @@ func (s service) Start(ctx context.Context, lambda *api.Lambda) error {
 		return nil
 	}
 
-	if err := s.client.ContainerStart(ctx, *lambda.Docker.ContainerId, types.ContainerStartOptions{}); err != nil {
+	if err := s.client.ContainerStart(ctx, id, types.ContainerStartOptions{}); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (s service) Stop(ctx context.Context, lambda *api.Lambda) error {
-	if lambda.Docker.ContainerId == nil {
-		return fmt.Errorf("lambda model is not complete")
+// WaitHealthy blocks until container healthcheck reports healthy state.
+// Containers without healthcheck are considered healthy once running.
+func (s service) WaitHealthy(ctx context.Context, id string) error {
+	for {
+		info, err := s.client.ContainerInspect(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if !info.State.Running && !info.State.Restarting {
+			return fmt.Errorf("container is not running: %s", info.State.Status)
+		}
+
+		if info.State.Health == nil {
+			if info.State.Running {
+				return nil
+			}
+		} else if info.State.Health.Status == types.Healthy {
+			return nil
+		} else if info.State.Health.Status == types.Unhealthy {
+			return errors.New("container is unhealthy")
+		}
+
+		select {
+		case <-time.After(time.Second):
+			continue
+		case <-ctx.Done():
+			return ctx.Err()
+		}
 	}
+}
 
-	info, err := s.client.ContainerInspect(ctx, *lambda.Docker.ContainerId)
+func (s service) Stop(ctx context.Context, id string) error {
+	info, err := s.client.ContainerInspect(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -177,42 +423,42 @@ func (s service) Stop(ctx context.Context, lambda *api.Lambda) error {
 		return nil
 	}
 
-	if err := s.client.ContainerStop(ctx, *lambda.Docker.ContainerId, nil); err != nil {
+	if err := s.client.ContainerStop(ctx, id, nil); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (s service) Remove(ctx context.Context, lambda *api.Lambda) error {
-	if lambda.Docker.Container == nil || lambda.Docker.Image == nil {
-		return fmt.Errorf("lambda model is not complete")
-	}
-
-	if err := s.Stop(ctx, lambda); err != nil {
+func (s service) RemoveImage(ctx context.Context, image string) error {
+	if _, err := s.client.ImageRemove(ctx, image, types.ImageRemoveOptions{}); err != nil {
 		return err
 	}
 
-	if err := s.client.ContainerRemove(ctx, *lambda.Docker.ContainerId, types.ContainerRemoveOptions{}); err != nil {
-		return err
-	}
+	return nil
+}
 
-	if _, err := s.client.ImageRemove(ctx, *lambda.Docker.Image, types.ImageRemoveOptions{}); err != nil {
+func (s service) RemoveContainer(ctx context.Context, id string) error {
+	if err := s.Stop(ctx, id); err != nil {
 		return err
 	}
 
-	return nil
+	return s.client.ContainerRemove(ctx, id, types.ContainerRemoveOptions{})
 }
 
 type ContainerCreator struct {
 	client    *client.Client
-	lambda    *api.Lambda
+	name      string
+	aliases   []string
 	container *container.ContainerCreateCreatedBody
+	// interrupted is set when creation is cancelled, so docker may have created the container without telling its id
+	interrupted bool
 }
 
-func (c *ContainerCreator) createContainer(ctx context.Context, conf *container.Config) error {
-	container, err := c.client.ContainerCreate(ctx, conf, nil, nil, nil, *c.lambda.Docker.Container)
+func (c *ContainerCreator) createContainer(ctx context.Context, conf *container.Config, hostConf *container.HostConfig) error {
+	container, err := c.client.ContainerCreate(ctx, conf, hostConf, nil, nil, c.name)
 	if err != nil {
+		c.interrupted = ctx.Err() != nil
 		return err
 	}
 
@@ -231,7 +477,7 @@ func (c *ContainerCreator) setupNetwork(ctx context.Context, opts types.NetworkL
 		return errors.New("invalid networks length")
 	}
 
-	if err := c.client.NetworkConnect(ctx, nets[0].ID, c.container.ID, &network.EndpointSettings{Aliases: []string{c.lambda.Name}}); err != nil {
+	if err := c.client.NetworkConnect(ctx, nets[0].ID, c.container.ID, &network.EndpointSettings{Aliases: c.aliases}); err != nil {
 		return err
 	}
 
@@ -255,11 +501,16 @@ func (c *ContainerCreator) rollback() {
 		}
 	}
 
-	if _, err := c.client.ImageRemove(ctx, *c.lambda.Docker.Image, types.ImageRemoveOptions{}); err != nil {
-		logger.L.Error(
-			"Failed to remove image",
-			zap.Error(err),
-			zap.String("id", *c.lambda.Docker.Image),
-		)
+	if c.interrupted {
+		err := c.client.ContainerRemove(ctx, c.name, types.ContainerRemoveOptions{Force: true})
+		if err != nil && !client.IsErrNotFound(err) {
+			logger.L.Error(
+				"Failed to remove container",
+				zap.Error(err),
+				zap.String("name", c.name),
+			)
+		} else {
+			c.interrupted = false
+		}
 	}
 }
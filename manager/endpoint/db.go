@@ -19,6 +19,10 @@ func SetEndpoint(ctx context.Context, endpoint *api.Endpoint) error {
 	return db.SetValue(ctx, "endpoint:"+endpoint.Id, endpoint)
 }
 
+func DeleteEndpoint(ctx context.Context, id string) error {
+	return db.DelValue(ctx, "endpoint:"+id)
+}
+
 func FindEndpoint(ctx context.Context, predicate func(val *api.Endpoint) bool) (*api.Endpoint, error) {
 	return db.FindValue(ctx, "endpoint", predicate)
 }
@@ -2,18 +2,22 @@ package endpoint
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	api "github.com/hedlx/doless/client"
 	"github.com/hedlx/doless/manager/lambda"
 	"github.com/hedlx/doless/manager/util"
+	"github.com/samber/lo"
 )
 
 type EndpointService interface {
 	List(ctx context.Context) ([]*api.Endpoint, error)
 	Get(ctx context.Context, id string) (*api.Endpoint, error)
 	Create(ctx context.Context, req *api.CreateEndpoint) (*api.Endpoint, error)
+	Update(ctx context.Context, id string, req *api.UpdateEndpoint) (*api.Endpoint, error)
+	Delete(ctx context.Context, id string) error
 }
 
 type endpointService struct {
@@ -35,30 +39,14 @@ func (s endpointService) Get(ctx context.Context, id string) (*api.Endpoint, err
 }
 
 func (s endpointService) Create(ctx context.Context, req *api.CreateEndpoint) (*api.Endpoint, error) {
-	lambda, err := lambda.GetLambda(ctx, req.Lambda)
-	if err != nil {
+	if err := checkTarget(ctx, req.Lambda, req.Alias); err != nil {
 		return nil, err
 	}
 
-	if lambda == nil {
-		return nil, fmt.Errorf("lambda is not found: %s", req.Lambda)
-	}
-
-	if lambda.LambdaType != "ENDPOINT" {
-		return nil, fmt.Errorf("lamda is not an endpoint")
-	}
-
-	existingEndpoint, err := FindEndpoint(ctx, func(val *api.Endpoint) bool {
-		return val.Path == req.Path
-	})
-	if err != nil {
+	if err := checkPath(ctx, "", req.Path); err != nil {
 		return nil, err
 	}
 
-	if existingEndpoint != nil {
-		return nil, fmt.Errorf("endpoint already exists: %s", existingEndpoint.Id)
-	}
-
 	now := time.Now().UnixMilli()
 	endpoint := &api.Endpoint{
 		Id:        util.UUID(),
@@ -67,11 +55,119 @@ func (s endpointService) Create(ctx context.Context, req *api.CreateEndpoint) (*
 		UpdatedAt: now,
 		Path:      req.Path,
 		Lambda:    req.Lambda,
+		Alias:     req.Alias,
+	}
+
+	if err := SetEndpoint(ctx, endpoint); err != nil {
+		return nil, err
+	}
+
+	return endpoint, nil
+}
+
+func (s endpointService) Update(ctx context.Context, id string, req *api.UpdateEndpoint) (*api.Endpoint, error) {
+	endpoint, err := GetEndpoint(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if endpoint == nil {
+		return nil, errors.New("not found")
+	}
+
+	if req.Lambda != nil && *req.Lambda != endpoint.Lambda {
+		// Aliases belong to the previous lambda
+		endpoint.Lambda = *req.Lambda
+		endpoint.Alias = nil
+	}
+
+	if req.Alias != nil {
+		endpoint.Alias = req.Alias
+		if *req.Alias == "" {
+			endpoint.Alias = nil
+		}
+	}
+
+	if req.Name != nil {
+		endpoint.Name = *req.Name
+	}
+
+	if req.Path != nil {
+		endpoint.Path = *req.Path
+	}
+
+	if err := checkTarget(ctx, endpoint.Lambda, endpoint.Alias); err != nil {
+		return nil, err
 	}
 
+	if err := checkPath(ctx, endpoint.Id, endpoint.Path); err != nil {
+		return nil, err
+	}
+
+	endpoint.UpdatedAt = time.Now().UnixMilli()
+
 	if err := SetEndpoint(ctx, endpoint); err != nil {
 		return nil, err
 	}
 
 	return endpoint, nil
 }
+
+func (s endpointService) Delete(ctx context.Context, id string) error {
+	endpoint, err := GetEndpoint(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if endpoint == nil {
+		return errors.New("not found")
+	}
+
+	return DeleteEndpoint(ctx, id)
+}
+
+// checkTarget ensures that endpoint can be served by the lambda and its alias.
+func checkTarget(ctx context.Context, lambdaID string, alias *string) error {
+	target, err := lambda.GetLambda(ctx, lambdaID)
+	if err != nil {
+		return err
+	}
+
+	if target == nil {
+		return fmt.Errorf("lambda is not found: %s", lambdaID)
+	}
+
+	if target.LambdaType != "ENDPOINT" {
+		return fmt.Errorf("lamda is not an endpoint")
+	}
+
+	if alias != nil {
+		found, exists := lo.Find(target.Aliases, func(a api.LambdaAlias) bool { return a.Name == *alias })
+		if !exists {
+			return fmt.Errorf("lambda alias is not found: %s", *alias)
+		}
+
+		// Handler routes to the version run by the lambda containers, so other versions have nothing serving them
+		if version := lambda.ServedVersion(target); found.Version != version {
+			return fmt.Errorf("lambda alias %s points to version %d, while version %d is deployed", *alias, found.Version, version)
+		}
+	}
+
+	return nil
+}
+
+// checkPath ensures that path is not taken by an endpoint other than the one with id.
+func checkPath(ctx context.Context, id string, path string) error {
+	existingEndpoint, err := FindEndpoint(ctx, func(val *api.Endpoint) bool {
+		return val.Path == path && val.Id != id
+	})
+	if err != nil {
+		return err
+	}
+
+	if existingEndpoint != nil {
+		return fmt.Errorf("endpoint already exists: %s", existingEndpoint.Id)
+	}
+
+	return nil
+}
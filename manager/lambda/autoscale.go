@@ -0,0 +1,139 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	api "github.com/hedlx/doless/client"
+	"github.com/hedlx/doless/manager/db"
+	"github.com/hedlx/doless/manager/logger"
+	"github.com/hedlx/doless/manager/model"
+	"go.uber.org/zap"
+)
+
+// GetMetrics returns lambda request metrics reported by the handler.
+func GetMetrics(ctx context.Context, id string) (*api.LambdaMetrics, error) {
+	return db.GetValue[api.LambdaMetrics](ctx, "metrics", id)
+}
+
+func DeleteMetrics(ctx context.Context, id string) error {
+	return db.DelValue(ctx, "metrics:"+id)
+}
+
+// freshMetrics treats metrics which the handler stopped reporting as no traffic.
+func freshMetrics(metrics *api.LambdaMetrics) api.LambdaMetrics {
+	if metrics == nil || time.Now().UnixMilli()-metrics.ReportedAt > 3*metrics.Interval {
+		return api.LambdaMetrics{}
+	}
+
+	return *metrics
+}
+
+// desiredReplicas returns number of replicas to serve the lambda load
+// with target concurrency per replica, adding one more while latency exceeds its target.
+func desiredReplicas(as *api.LambdaAutoscaling, metrics api.LambdaMetrics, current int) int {
+	concurrency := metrics.PeakInFlight
+	if metrics.InFlight > concurrency {
+		concurrency = metrics.InFlight
+	}
+
+	desired := int((concurrency + as.TargetConcurrency - 1) / as.TargetConcurrency)
+
+	if as.TargetLatency != nil && metrics.Requests > 0 && metrics.AvgLatency > *as.TargetLatency && desired <= current {
+		desired = current + 1
+	}
+
+	if desired < int(as.MinReplicas) {
+		desired = int(as.MinReplicas)
+	}
+
+	if desired > int(as.MaxReplicas) {
+		desired = int(as.MaxReplicas)
+	}
+
+	return desired
+}
+
+// autoscale adjusts number of running lambda replicas to the load reported by the handler.
+func (s service) autoscale(ctx context.Context, lambda *api.Lambda) {
+	if lambda.Autoscaling == nil || !deployed(lambda) || stopped(lambda) {
+		return
+	}
+
+	metrics, err := GetMetrics(ctx, lambda.Id)
+	if err != nil {
+		logger.L.Error(
+			"Failed to get lambda metrics",
+			zap.Error(err),
+			zap.String("id", lambda.Id),
+		)
+		return
+	}
+
+	as := model.WithDefaultAutoscaling(*lambda.Autoscaling)
+	m := freshMetrics(metrics)
+	current := len(lambda.Docker.Replicas)
+	desired := desiredReplicas(&as, m, current)
+
+	if desired == current {
+		return
+	}
+
+	reason := ScaledUpEvent
+	cooldown := time.Duration(*as.ScaleUpCooldown) * time.Second
+	if desired < current {
+		reason = ScaledDownEvent
+		cooldown = time.Duration(*as.ScaleDownCooldown) * time.Second
+	}
+
+	if time.Since(s.scaledAt.Get(lambda.Id, time.Time{})) < cooldown {
+		return
+	}
+
+	message := fmt.Sprintf(
+		"%d -> %d replicas: peak in-flight %d, average latency %dms",
+		current, desired, m.PeakInFlight, m.AvgLatency,
+	)
+
+	if err := s.Scale(ctx, lambda.Id, int64(desired)); err != nil {
+		reason = ScaleFailedEvent
+		message = fmt.Sprintf("%s: %s", message, err)
+	}
+
+	s.scaledAt.Set(lambda.Id, time.Now())
+
+	reportEvent(ctx, lambda.Id, reason, message)
+}
+
+// SetAutoscaling changes autoscaling settings of the lambda, nil disables autoscaling keeping current replicas.
+func (s service) SetAutoscaling(ctx context.Context, id string, as *api.LambdaAutoscaling) (*api.Lambda, error) {
+	if succ := s.starting.AddUniq(id); !succ {
+		return nil, fmt.Errorf("lambda '%s' is already being processed", id)
+	}
+	defer s.starting.Remove(id)
+
+	lambda, err := GetLambda(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if lambda == nil {
+		return nil, errors.New("not found")
+	}
+
+	lambda.Autoscaling = nil
+	if as != nil {
+		withDefaults := model.WithDefaultAutoscaling(*as)
+		lambda.Autoscaling = &withDefaults
+	}
+
+	lambda.UpdatedAt = time.Now().UnixMilli()
+
+	if err := s.updateLambda(ctx, *lambda); err != nil {
+		return nil, err
+	}
+
+	return lambda, nil
+}
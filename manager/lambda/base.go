@@ -0,0 +1,212 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	api "github.com/hedlx/doless/client"
+	"github.com/hedlx/doless/manager/docker"
+	"github.com/hedlx/doless/manager/logger"
+	"github.com/hedlx/doless/manager/model"
+	"go.uber.org/zap"
+)
+
+// RuntimeImagePrefix starts names of runtime base images, they are tagged "doless-runtime-<runtime id>:<revision>".
+const RuntimeImagePrefix = "doless-runtime-"
+
+// Statuses of the runtime base image.
+const (
+	BaseBuildingStatus    = "BUILDING"
+	BaseReadyStatus       = "READY"
+	BaseBuildFailedStatus = "BUILD_FAILED"
+)
+
+func baseImage(id string, revision int64) string {
+	return fmt.Sprintf("%s%s:%d", RuntimeImagePrefix, id, revision)
+}
+
+// pendingBase returns base image of the runtime revision which is yet to be built.
+func pendingBase(id string, revision int64) *api.RuntimeBaseImage {
+	return &api.RuntimeBaseImage{
+		Image:    baseImage(id, revision),
+		Revision: revision,
+		Status:   BaseBuildingStatus,
+	}
+}
+
+// baseBuildArgs passes the runtime base image to lambda builds, they can't be built until it is ready.
+func baseBuildArgs(runtime *api.Runtime) (map[string]*string, error) {
+	base := runtime.BaseImage
+	if base == nil {
+		return nil, nil
+	}
+
+	if base.Status != BaseReadyStatus {
+		return nil, fmt.Errorf("base image of runtime '%s' is not ready: %s", runtime.Name, base.Status)
+	}
+
+	return map[string]*string{model.BaseImageArg: &base.Image}, nil
+}
+
+// BuildRuntimeBase builds base image of the runtime. Previous base images are removed by the reconciler.
+func (s *service) BuildRuntimeBase(ctx context.Context, id string, progress docker.BuildProgress) error {
+	if succ := s.runtimes.AddUniq(id); !succ {
+		return fmt.Errorf("runtime '%s' is already being processed", id)
+	}
+	defer s.runtimes.Remove(id)
+
+	runtime, err := GetRuntime(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if runtime == nil {
+		return errors.New("not found")
+	}
+
+	if runtime.BaseImage == nil {
+		return errors.New("runtime has no base image")
+	}
+
+	return s.buildBase(ctx, runtime, progress)
+}
+
+func (s service) buildBase(ctx context.Context, runtime *api.Runtime, progress docker.BuildProgress) error {
+	base := runtime.BaseImage
+
+	exists, err := s.dockerSvc.ImageExists(ctx, base.Image)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		// Image is tagged once the build succeeds, so it's only the status which is missing
+		return s.setBaseStatus(ctx, runtime, BaseReadyStatus, nil)
+	}
+
+	if err := s.setBaseStatus(ctx, runtime, BaseBuildingStatus, nil); err != nil {
+		return err
+	}
+
+	tar, err := TarRuntimeBase(ctx, runtime.Id, base.Revision)
+	if err == nil {
+		err = s.dockerSvc.Build(ctx, base.Image, tar, nil, progress)
+	}
+
+	if err != nil {
+		rctx, cancel := rollbackContext()
+		defer cancel()
+
+		reason := err.Error()
+		if sErr := s.setBaseStatus(rctx, runtime, BaseBuildFailedStatus, &reason); sErr != nil {
+			logger.L.Error(
+				"Failed to update runtime",
+				zap.Error(sErr),
+				zap.String("id", runtime.Id),
+			)
+		}
+
+		return err
+	}
+
+	return s.setBaseStatus(ctx, runtime, BaseReadyStatus, nil)
+}
+
+func (s service) setBaseStatus(ctx context.Context, runtime *api.Runtime, status string, reason *string) error {
+	runtime.BaseImage.Status = status
+	runtime.BaseImage.StatusReason = reason
+
+	if status == BaseReadyStatus {
+		builtAt := time.Now().UnixMilli()
+		runtime.BaseImage.BuiltAt = &builtAt
+	}
+
+	return SetRuntime(ctx, runtime)
+}
+
+// reconcileRuntimes rebuilds missing base images of the runtimes. Builds interrupted by manager restart
+// are continued on startup.
+func (s service) reconcileRuntimes(ctx context.Context, startup bool) error {
+	runtimes, err := GetRuntimes(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, runtime := range runtimes {
+		base := runtime.BaseImage
+		if base == nil || base.Status == BaseBuildFailedStatus || (base.Status == BaseBuildingStatus && !startup) {
+			continue
+		}
+
+		if err := s.reconcileRuntime(ctx, runtime.Id); err != nil {
+			logger.L.Error(
+				"Failed to reconcile runtime",
+				zap.Error(err),
+				zap.String("id", runtime.Id),
+			)
+		}
+	}
+
+	return nil
+}
+
+func (s service) reconcileRuntime(ctx context.Context, id string) error {
+	if succ := s.runtimes.AddUniq(id); !succ {
+		return nil
+	}
+	defer s.runtimes.Remove(id)
+
+	runtime, err := GetRuntime(ctx, id)
+	if err != nil || runtime == nil || runtime.BaseImage == nil {
+		return err
+	}
+
+	if runtime.BaseImage.Status == BaseReadyStatus {
+		exists, err := s.dockerSvc.ImageExists(ctx, runtime.BaseImage.Image)
+		if err != nil || exists {
+			return err
+		}
+	}
+
+	return s.buildBase(ctx, runtime, nil)
+}
+
+// removeOrphanBase removes runtime base image unless it is the current base image of its runtime.
+func (s service) removeOrphanBase(ctx context.Context, tag string) {
+	name, _, _ := strings.Cut(tag, ":")
+	id := strings.TrimPrefix(name, RuntimeImagePrefix)
+
+	if succ := s.runtimes.AddUniq(id); !succ {
+		return
+	}
+	defer s.runtimes.Remove(id)
+
+	runtime, err := GetRuntime(ctx, id)
+	if err != nil {
+		logger.L.Error(
+			"Failed to gather runtime",
+			zap.Error(err),
+			zap.String("id", id),
+		)
+		return
+	}
+
+	if runtime != nil && runtime.BaseImage != nil && runtime.BaseImage.Image == tag {
+		return
+	}
+
+	// Images of the lambdas built from it keep its layers
+	if err := s.dockerSvc.RemoveImage(ctx, tag); err != nil {
+		logger.L.Error(
+			"Failed to remove orphan",
+			zap.Error(err),
+			zap.String("object", "image "+tag),
+		)
+		return
+	}
+
+	logger.L.Info("Removed orphan", zap.String("object", "image "+tag), zap.String("runtime", id))
+}
@@ -0,0 +1,281 @@
+package lambda
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	api "github.com/hedlx/doless/client"
+	"github.com/hedlx/doless/manager/archive"
+	"github.com/hedlx/doless/manager/common"
+	"github.com/hedlx/doless/manager/logger"
+	"github.com/hedlx/doless/manager/model"
+	"github.com/hedlx/doless/manager/util"
+	"github.com/minio/minio-go/v7"
+	"go.uber.org/zap"
+)
+
+// Lambda sources are stored content-addressed: every file is a blob named by its sha256 in the blob bucket,
+// which is shared by all lambdas and versions, while the manifest of the lambda version lists its files.
+
+const manifestSuffix = ".manifest"
+
+// blobGCInterval is how often blobs which are referenced by no manifest are removed.
+var blobGCInterval = time.Duration(util.GetIntVarDefault("BLOB_GC_INTERVAL", 3600)) * time.Second
+
+// blobsLock keeps blobs which are about to be referenced by a new manifest from being collected.
+var blobsLock sync.RWMutex
+
+// leasedBlobs keeps time the blobs were reported stored by MissingBlobs, they are not collected for TMP_TTL after it.
+var leasedBlobs = common.CreateConcurrentMap[string, time.Time]()
+
+// manifestObject returns the manifest object of the lambda version in the lambda bucket.
+func manifestObject(id string, version int64) string {
+	return fmt.Sprintf("%s/%d%s", id, version, manifestSuffix)
+}
+
+func isNoSuchKey(err error) bool {
+	return minio.ToErrorResponse(err).Code == "NoSuchKey"
+}
+
+func statBlob(ctx context.Context, digest string) (*minio.ObjectInfo, error) {
+	info, err := minioCli.StatObject(ctx, blobBucket, digest, minio.StatObjectOptions{})
+	if err != nil {
+		if isNoSuchKey(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// MissingBlobs returns digests of the blobs which are not stored yet.
+// Stored blobs are leased, so they aren't collected before the manifest referencing them is bootstrapped.
+func MissingBlobs(ctx context.Context, digests []string) ([]string, error) {
+	blobsLock.RLock()
+	defer blobsLock.RUnlock()
+
+	missing := []string{}
+
+	for _, digest := range digests {
+		info, err := statBlob(ctx, digest)
+		if err != nil {
+			return nil, err
+		}
+
+		if info == nil {
+			missing = append(missing, digest)
+		} else {
+			leasedBlobs.Set(digest, time.Now())
+		}
+	}
+
+	return missing, nil
+}
+
+// UploadBlob stores the uploaded file as the blob, it is rejected unless its content matches the digest.
+func UploadBlob(ctx context.Context, digest string, file io.Reader) error {
+	tmp, err := os.CreateTemp("", "doless-blob-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hash := sha256.New()
+	written, err := io.CopyN(io.MultiWriter(tmp, hash), file, archive.MaxUnarchivedSize+1)
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	if written > archive.MaxUnarchivedSize {
+		return fmt.Errorf("blob size exceeds %d bytes", archive.MaxUnarchivedSize)
+	}
+
+	if hex.EncodeToString(hash.Sum(nil)) != digest {
+		return errors.New("blob content doesn't match the digest")
+	}
+
+	_, err = minioCli.FPutObject(ctx, blobBucket, digest, tmp.Name(), minio.PutObjectOptions{})
+	return err
+}
+
+// putBlob stores the file as the blob unless it is already stored and returns its digest.
+func putBlob(ctx context.Context, file string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	digest := hex.EncodeToString(hash.Sum(nil))
+
+	info, err := statBlob(ctx, digest)
+	if err != nil || info != nil {
+		return digest, err
+	}
+
+	_, err = minioCli.FPutObject(ctx, blobBucket, digest, file, minio.PutObjectOptions{})
+	return digest, err
+}
+
+func putManifest(ctx context.Context, id string, version int64, files []api.LambdaManifestFile) error {
+	data, err := json.Marshal(files)
+	if err != nil {
+		return err
+	}
+
+	_, err = minioCli.PutObject(ctx, lambdaBucket, manifestObject(id, version), bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType: "application/json",
+	})
+	return err
+}
+
+// getManifest returns files of the lambda version, it is nil for versions stored file by file before manifests were introduced.
+func getManifest(ctx context.Context, id string, version int64) ([]api.LambdaManifestFile, error) {
+	return readManifest(ctx, manifestObject(id, version))
+}
+
+func readManifest(ctx context.Context, object string) ([]api.LambdaManifestFile, error) {
+	reader, err := minioCli.GetObject(ctx, lambdaBucket, object, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	files := []api.LambdaManifestFile{}
+	if err := json.NewDecoder(reader).Decode(&files); err != nil {
+		if isNoSuchKey(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// BootstrapManifest stores manifest of the lambda version made of already uploaded blobs.
+// Manifest is held to the same limits as archives.
+func BootstrapManifest(ctx context.Context, id string, version int64, manifest []api.LambdaManifestFile) error {
+	files, err := model.CleanManifest(manifest)
+	if err != nil {
+		return err
+	}
+
+	blobsLock.RLock()
+	defer blobsLock.RUnlock()
+
+	var size int64
+
+	for _, file := range files {
+		info, err := statBlob(ctx, file.Digest)
+		if err != nil {
+			return err
+		}
+
+		if info == nil {
+			return fmt.Errorf("blob %s of %s is not uploaded", file.Digest, file.Path)
+		}
+
+		size += info.Size
+	}
+
+	if size > archive.MaxUnarchivedSize {
+		return fmt.Errorf("invalid manifest: size exceeds %d bytes", archive.MaxUnarchivedSize)
+	}
+
+	return putManifest(ctx, id, version, files)
+}
+
+func (s service) blobsRoutine(ctx context.Context) {
+	for {
+		select {
+		case <-time.After(blobGCInterval):
+		case <-ctx.Done():
+			return
+		}
+
+		if err := removeOrphanBlobs(ctx); err != nil {
+			logger.L.Error("Failed to remove orphan blobs", zap.Error(err))
+		}
+	}
+}
+
+// removeOrphanBlobs removes blobs which are referenced by no manifest. Blobs uploaded or leased within TMP_TTL are kept,
+// since they may belong to the lambda which is yet to be created.
+func removeOrphanBlobs(ctx context.Context) error {
+	blobsLock.Lock()
+	defer blobsLock.Unlock()
+
+	expired := []string{}
+	leasedBlobs.ForEach(func(digest string, leasedAt time.Time) {
+		if time.Since(leasedAt) >= tmpTTL*time.Second {
+			expired = append(expired, digest)
+		}
+	})
+
+	for _, digest := range expired {
+		leasedBlobs.Delete(digest)
+	}
+
+	referenced := map[string]bool{}
+
+	for object := range minioCli.ListObjects(ctx, lambdaBucket, minio.ListObjectsOptions{Recursive: true}) {
+		if object.Err != nil {
+			return object.Err
+		}
+
+		if !strings.HasSuffix(object.Key, manifestSuffix) {
+			continue
+		}
+
+		files, err := readManifest(ctx, object.Key)
+		if err != nil {
+			return err
+		}
+
+		for _, file := range files {
+			referenced[file.Digest] = true
+		}
+	}
+
+	for object := range minioCli.ListObjects(ctx, blobBucket, minio.ListObjectsOptions{Recursive: true}) {
+		if object.Err != nil {
+			return object.Err
+		}
+
+		leased := !leasedBlobs.Get(object.Key, time.Time{}).IsZero()
+		if referenced[object.Key] || leased || time.Since(object.LastModified) < tmpTTL*time.Second {
+			continue
+		}
+
+		if err := minioCli.RemoveObject(ctx, blobBucket, object.Key, minio.RemoveObjectOptions{}); err != nil {
+			logger.L.Error(
+				"Failed to remove orphan",
+				zap.Error(err),
+				zap.String("object", "blob "+object.Key),
+			)
+			continue
+		}
+
+		logger.L.Info("Removed orphan", zap.String("object", "blob "+object.Key))
+	}
+
+	return nil
+}
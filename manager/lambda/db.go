@@ -5,6 +5,7 @@ import (
 
 	api "github.com/hedlx/doless/client"
 	"github.com/hedlx/doless/manager/db"
+	"github.com/samber/lo"
 )
 
 func GetLambda(ctx context.Context, id string) (*api.Lambda, error) {
@@ -31,6 +32,24 @@ func SetRuntime(ctx context.Context, runtime *api.Runtime) error {
 	return db.SetValue(ctx, "runtime:"+runtime.Id, runtime)
 }
 
+func DeleteLambda(ctx context.Context, id string) error {
+	return db.DelValue(ctx, "lambda:"+id)
+}
+
+func DeleteRuntime(ctx context.Context, id string) error {
+	return db.DelValue(ctx, "runtime:"+id)
+}
+
+// GetRuntimeLambdas returns lambdas built on top of the runtime.
+func GetRuntimeLambdas(ctx context.Context, runtime string) ([]*api.Lambda, error) {
+	lambdas, err := GetLambdas(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Filter(lambdas, func(l *api.Lambda, _ int) bool { return l.Runtime == runtime }), nil
+}
+
 func FindLambda(ctx context.Context, predicate func(val *api.Lambda) bool) (*api.Lambda, error) {
 	return db.FindValue(ctx, "lambda", predicate)
 }
@@ -0,0 +1,73 @@
+package lambda
+
+import (
+	"context"
+	"time"
+
+	api "github.com/hedlx/doless/client"
+	"github.com/hedlx/doless/manager/db"
+	"github.com/hedlx/doless/manager/logger"
+	"go.uber.org/zap"
+)
+
+// maxEvents is the number of the latest lambda events which are kept.
+const maxEvents = 100
+
+const (
+	ScaledUpEvent           = "ScaledUp"
+	ScaledDownEvent         = "ScaledDown"
+	ScaleFailedEvent        = "ScaleFailed"
+	ContainerRecreatedEvent = "ContainerRecreated"
+	ContainerRestartedEvent = "ContainerRestarted"
+	ImageRebuiltEvent       = "ImageRebuilt"
+	OrphanRemovedEvent      = "OrphanRemoved"
+	ReconcileFailedEvent    = "ReconcileFailed"
+	RuntimeMigratedEvent    = "RuntimeMigrated"
+)
+
+func GetEvents(ctx context.Context, id string) ([]api.LambdaEvent, error) {
+	events, err := db.GetValue[[]api.LambdaEvent](ctx, "event", id)
+	if err != nil {
+		return nil, err
+	}
+
+	if events == nil {
+		return []api.LambdaEvent{}, nil
+	}
+
+	return *events, nil
+}
+
+func AddEvent(ctx context.Context, id string, reason string, message string) error {
+	events, err := GetEvents(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	events = append(events, api.LambdaEvent{
+		Reason:    reason,
+		Message:   message,
+		CreatedAt: time.Now().UnixMilli(),
+	})
+
+	if len(events) > maxEvents {
+		events = events[len(events)-maxEvents:]
+	}
+
+	return db.SetValue(ctx, "event:"+id, events)
+}
+
+// reportEvent adds lambda event, failure to add it is only logged as events are informational.
+func reportEvent(ctx context.Context, id string, reason string, message string) {
+	if err := AddEvent(ctx, id, reason, message); err != nil {
+		logger.L.Error(
+			"Failed to add lambda event",
+			zap.Error(err),
+			zap.String("id", id),
+		)
+	}
+}
+
+func DeleteEvents(ctx context.Context, id string) error {
+	return db.DelValue(ctx, "event:"+id)
+}
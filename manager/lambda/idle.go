@@ -0,0 +1,191 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	api "github.com/hedlx/doless/client"
+	"github.com/hedlx/doless/manager/db"
+	"github.com/hedlx/doless/manager/model"
+)
+
+// GetActivity returns time of the last lambda request in unix milliseconds. It is reported by the handler.
+func GetActivity(ctx context.Context, id string) (*int64, error) {
+	return db.GetValue[int64](ctx, "activity", id)
+}
+
+func SetActivity(ctx context.Context, id string, at int64) error {
+	return db.SetValue(ctx, "activity:"+id, at)
+}
+
+func DeleteActivity(ctx context.Context, id string) error {
+	return db.DelValue(ctx, "activity:"+id)
+}
+
+// idleExpired reports whether running lambda has received no requests for longer than its idle timeout.
+func idleExpired(ctx context.Context, lambda *api.Lambda) (bool, error) {
+	if lambda.IdleTimeout == nil || *lambda.IdleTimeout <= 0 || !deployed(lambda) || stopped(lambda) {
+		return false, nil
+	}
+
+	at, err := GetActivity(ctx, lambda.Id)
+	if err != nil {
+		return false, err
+	}
+
+	now := time.Now().UnixMilli()
+
+	if at == nil {
+		// Lambda started before idle timeout was set, so it is counted from now on
+		return false, SetActivity(ctx, lambda.Id, now)
+	}
+
+	return now-*at > *lambda.IdleTimeout*1000, nil
+}
+
+// idle stops containers of the lambda which hasn't been requested for a while.
+func (s service) idle(ctx context.Context, id string) error {
+	if succ := s.starting.AddUniq(id); !succ {
+		return fmt.Errorf("lambda '%s' is already being processed", id)
+	}
+	defer s.starting.Remove(id)
+
+	lambda, err := GetLambda(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if lambda == nil {
+		return errors.New("not found")
+	}
+
+	if expired, err := idleExpired(ctx, lambda); err != nil || !expired {
+		// Request came while the lock was being taken
+		return err
+	}
+
+	if err := transition(lambda, model.IdleState, "lambda is idle"); err != nil {
+		return err
+	}
+
+	if err := s.stopReplicas(ctx, lambda.Docker.Replicas); err != nil {
+		return err
+	}
+
+	setStatus(lambda, ExitedStatus)
+
+	return s.updateLambda(ctx, *lambda)
+}
+
+// lockPollInterval is how often the lambda lock is checked while waiting for it.
+const lockPollInterval = 100 * time.Millisecond
+
+// waitLock takes the lambda lock once the operation holding it is finished.
+func (s service) waitLock(ctx context.Context, id string) error {
+	for !s.starting.AddUniq(id) {
+		select {
+		case <-time.After(lockPollInterval):
+		case <-ctx.Done():
+			return fmt.Errorf("lambda '%s' is still being processed: %w", id, ctx.Err())
+		}
+	}
+
+	return nil
+}
+
+// Wake starts idle lambda and waits until its containers are healthy, the time it takes is recorded as a cold start.
+// Lambdas which are already running are left as is. Wake waits for the operation in flight, e.g. idle stop or reconciliation,
+// and acts on the lambda it leaves.
+func (s service) Wake(ctx context.Context, id string) error {
+	if err := s.waitLock(ctx, id); err != nil {
+		return err
+	}
+	defer s.starting.Remove(id)
+
+	lambda, err := GetLambda(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if lambda == nil {
+		return errors.New("not found")
+	}
+
+	if state := lambdaState(lambda); state != model.IdleState {
+		if !deployed(lambda) || state == model.StoppedState {
+			return errors.New("lambda is not started")
+		}
+
+		return nil
+	}
+
+	began := time.Now()
+
+	if err := s.launch(ctx, lambda, nil); err != nil {
+		return err
+	}
+
+	hctx, cancel := context.WithTimeout(ctx, healthyTimeout)
+	defer cancel()
+
+	for i, replica := range lambda.Docker.Replicas {
+		if err := s.dockerSvc.WaitHealthy(hctx, replica.ContainerId); err != nil {
+			rctx, cancel := rollbackContext()
+			defer cancel()
+
+			s.updateLambda(rctx, *lambda)
+			s.resyncLambda(rctx, lambda.Id)
+			return fmt.Errorf("lambda failed to become healthy: %w", err)
+		}
+
+		lambda.Docker.Replicas[i].Status = s.replicaStatus(ctx, replica.ContainerId)
+	}
+
+	lambda.Docker.Status = aggregateStatus(lambda.Docker.Replicas)
+	followReplicas(lambda)
+
+	now := time.Now()
+	duration := now.Sub(began).Milliseconds()
+	coldStarts := lambda.GetColdStarts()
+	coldStarts.Count++
+	coldStarts.LastDuration = duration
+	coldStarts.TotalDuration += duration
+	coldStarts.LastAt = now.UnixMilli()
+	lambda.ColdStarts = &coldStarts
+
+	if err := s.updateLambda(ctx, *lambda); err != nil {
+		return err
+	}
+
+	s.resyncLambda(ctx, lambda.Id)
+
+	return nil
+}
+
+// SetIdleTimeout changes idle timeout of the lambda, 0 disables it.
+func (s service) SetIdleTimeout(ctx context.Context, id string, timeout int64) (*api.Lambda, error) {
+	if succ := s.starting.AddUniq(id); !succ {
+		return nil, fmt.Errorf("lambda '%s' is already being processed", id)
+	}
+	defer s.starting.Remove(id)
+
+	lambda, err := GetLambda(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if lambda == nil {
+		return nil, errors.New("not found")
+	}
+
+	lambda.IdleTimeout = &timeout
+	lambda.UpdatedAt = time.Now().UnixMilli()
+
+	if err := s.updateLambda(ctx, *lambda); err != nil {
+		return nil, err
+	}
+
+	return lambda, nil
+}
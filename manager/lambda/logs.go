@@ -0,0 +1,94 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	api "github.com/hedlx/doless/client"
+	"github.com/hedlx/doless/manager/docker"
+)
+
+// Logs reads output of the lambda replicas, or only the given one. Lines are passed to emit ordered by time,
+// followed lines are passed as soon as they come.
+func (s service) Logs(ctx context.Context, id string, replica *int64, opts docker.LogsOptions, emit func(api.LambdaLogLine)) error {
+	lambda, err := GetLambda(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if lambda == nil {
+		return errors.New("not found")
+	}
+
+	replicas := map[int64]api.Replica{}
+	for i, r := range lambda.Docker.Replicas {
+		if replica == nil || *replica == int64(i) {
+			replicas[int64(i)] = r
+		}
+	}
+
+	if len(replicas) == 0 {
+		return errors.New("lambda has no such containers")
+	}
+
+	if !opts.Follow {
+		lines := []api.LambdaLogLine{}
+		for i, r := range replicas {
+			err := s.dockerSvc.Logs(ctx, r.ContainerId, opts, func(line docker.LogLine) {
+				lines = append(lines, logLine(i, line))
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		sort.SliceStable(lines, func(a, b int) bool { return lines[a].Time < lines[b].Time })
+
+		for _, line := range lines {
+			emit(line)
+		}
+
+		return nil
+	}
+
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	errs := make(chan error, len(replicas))
+
+	for i, r := range replicas {
+		wg.Add(1)
+
+		go func(i int64, r api.Replica) {
+			defer wg.Done()
+
+			errs <- s.dockerSvc.Logs(ctx, r.ContainerId, opts, func(line docker.LogLine) {
+				lock.Lock()
+				defer lock.Unlock()
+
+				emit(logLine(i, line))
+			})
+		}(i, r)
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func logLine(replica int64, line docker.LogLine) api.LambdaLogLine {
+	return api.LambdaLogLine{
+		Replica: replica,
+		Stream:  line.Stream,
+		Time:    line.Time.UnixMilli(),
+		Text:    line.Text,
+	}
+}
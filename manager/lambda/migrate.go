@@ -0,0 +1,129 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	api "github.com/hedlx/doless/client"
+	"github.com/hedlx/doless/manager/docker"
+	"github.com/samber/lo"
+)
+
+// outdated reports whether the deployed lambda is built with older revision of its runtime.
+// Lambdas built before revisions were recorded are considered outdated.
+func outdated(lambda *api.Lambda, runtime *api.Runtime) bool {
+	return deployed(lambda) && lambda.Docker.GetRuntimeRevision() < runtime.Revision
+}
+
+// RolloutRuntime rebuilds lambdas built with older revisions of the runtime. Lambdas of a batch are rebuilt
+// at once, the next batch isn't started once any of them fails. Lambdas which aren't deployed are built
+// with the current revision on their next start anyway.
+func (s service) RolloutRuntime(ctx context.Context, id string, batch int, progress docker.BuildProgress) error {
+	runtime, err := GetRuntime(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if runtime == nil {
+		return errors.New("not found")
+	}
+
+	lambdas, err := GetRuntimeLambdas(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	lambdas = lo.Filter(lambdas, func(l *api.Lambda, _ int) bool { return outdated(l, runtime) })
+	sort.Slice(lambdas, func(i, j int) bool { return lambdas[i].Id < lambdas[j].Id })
+
+	progress(fmt.Sprintf("%d lambdas are built with older revisions of the runtime", len(lambdas)))
+
+	for from := 0; from < len(lambdas); from += batch {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		to := from + batch
+		if to > len(lambdas) {
+			to = len(lambdas)
+		}
+
+		errs := make([]error, to-from)
+		wg := sync.WaitGroup{}
+
+		for i, lambda := range lambdas[from:to] {
+			i, lambdaID := i, lambda.Id
+			progress(fmt.Sprintf("Rebuilding lambda %s", lambdaID))
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				errs[i] = s.migrate(ctx, lambdaID, func(line string) { progress(lambdaID + ": " + line) })
+			}()
+		}
+
+		wg.Wait()
+
+		for i, err := range errs {
+			if err != nil {
+				return fmt.Errorf("failed to rebuild lambda %s: %w", lambdas[from+i].Id, err)
+			}
+
+			progress(fmt.Sprintf("Lambda %s is rebuilt", lambdas[from+i].Id))
+		}
+	}
+
+	return nil
+}
+
+// migrate rebuilds the lambda with the current revision of its runtime. Running lambda is replaced
+// without downtime, stopped one is stopped again after the build.
+func (s service) migrate(ctx context.Context, id string, progress docker.BuildProgress) error {
+	if succ := s.starting.AddUniq(id); !succ {
+		return fmt.Errorf("lambda '%s' is already being processed", id)
+	}
+	defer s.starting.Remove(id)
+
+	lambda, err := GetLambda(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if lambda == nil {
+		return errors.New("not found")
+	}
+
+	runtime, err := GetRuntime(ctx, lambda.Runtime)
+	if err != nil {
+		return err
+	}
+
+	if runtime == nil {
+		return fmt.Errorf("runtime is not found: %s", lambda.Runtime)
+	}
+
+	// Lambda may have been rebuilt or destroyed since the rollout started
+	if !outdated(lambda, runtime) {
+		return nil
+	}
+
+	prevRevision := lambda.Docker.GetRuntimeRevision()
+
+	if stopped(lambda) {
+		err = s.rebuild(ctx, lambda, progress)
+	} else {
+		err = s.replace(ctx, lambda, progress)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	reportEvent(ctx, id, RuntimeMigratedEvent, fmt.Sprintf("rebuilt with runtime revision %d instead of %d", runtime.Revision, prevRevision))
+
+	return nil
+}
@@ -2,6 +2,7 @@ package lambda
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"os"
 	"path"
@@ -10,11 +11,11 @@ import (
 	"time"
 
 	"github.com/gabriel-vasile/mimetype"
-	"github.com/mholt/archiver/v3"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 
 	api "github.com/hedlx/doless/client"
+	"github.com/hedlx/doless/manager/archive"
 	"github.com/hedlx/doless/manager/util"
 )
 
@@ -22,13 +23,17 @@ var minioCli *minio.Client
 
 const (
 	lambdaBucket  = "lambda"
+	blobBucket    = "lambda-blob"
 	tmpBucket     = "lambda-tmp"
 	runtimeBucket = "runtime"
 )
 
-var tmpTTL time.Duration = time.Duration(util.GetIntVar("TMP_TTL"))
+var tmpTTL time.Duration
+
+// connectMinio connects to minio and creates missing buckets, it blocks until minio is available.
+func connectMinio() error {
+	tmpTTL = time.Duration(util.GetIntVar("TMP_TTL"))
 
-func init() {
 	endpoint := util.GetStrVar("MINIO_ENDPOINT")
 	accessKeyID := util.GetStrVar("MINIO_ACCESS_KEY")
 	secretAccessKey := util.GetStrVar("MINIO_SECRET_KEY")
@@ -39,7 +44,7 @@ func init() {
 	})
 
 	if err != nil {
-		panic(err)
+		return err
 	}
 
 	ctx := context.Background()
@@ -55,16 +60,18 @@ func init() {
 	}
 
 	if err = createBucketIfNecessary(ctx, lambdaBucket); err != nil {
-		panic(err)
+		return err
 	}
 
-	if err = createBucketIfNecessary(ctx, tmpBucket); err != nil {
-		panic(err)
+	if err = createBucketIfNecessary(ctx, blobBucket); err != nil {
+		return err
 	}
 
-	if err = createBucketIfNecessary(ctx, runtimeBucket); err != nil {
-		panic(err)
+	if err = createBucketIfNecessary(ctx, tmpBucket); err != nil {
+		return err
 	}
+
+	return createBucketIfNecessary(ctx, runtimeBucket)
 }
 
 func createBucketIfNecessary(ctx context.Context, bucket string) error {
@@ -98,12 +105,23 @@ func UploadTmp(ctx context.Context, file io.Reader) (string, error) {
 	return id, err
 }
 
-func BootstrapLambda(ctx context.Context, id string, lambda *api.CreateLambda) error {
-	archive, err := minioCli.GetObject(ctx, tmpBucket, lambda.Archive, minio.GetObjectOptions{})
+// lambdaPrefix returns the object prefix of the lambda version in the lambda bucket.
+// Version 0 stands for lambdas created before versioning was introduced.
+func lambdaPrefix(id string, version int64) string {
+	if version == 0 {
+		return id + "/"
+	}
+
+	return fmt.Sprintf("%s/%d/", id, version)
+}
+
+// BootstrapLambda stores files of the uploaded archive as blobs together with the manifest of the lambda version.
+func BootstrapLambda(ctx context.Context, id string, version int64, archiveID string) error {
+	object, err := minioCli.GetObject(ctx, tmpBucket, archiveID, minio.GetObjectOptions{})
 	if err != nil {
 		return err
 	}
-	defer archive.Close()
+	defer object.Close()
 
 	tmpDir, err := os.MkdirTemp("", "doless-")
 	if err != nil {
@@ -111,18 +129,23 @@ func BootstrapLambda(ctx context.Context, id string, lambda *api.CreateLambda) e
 	}
 	defer os.RemoveAll(tmpDir)
 
-	archivePath := path.Join(tmpDir, lambda.Archive)
+	archivePath := path.Join(tmpDir, archiveID)
 	archFile, err := os.OpenFile(archivePath, os.O_CREATE|os.O_RDWR, 0777)
 	if err != nil {
 		return err
 	}
 	defer archFile.Close()
 
-	_, err = io.Copy(archFile, archive)
-	if err != nil {
+	// Size of the uploaded object is only known once it's read
+	written, err := io.CopyN(archFile, object, archive.MaxSize+1)
+	if err != nil && err != io.EOF {
 		return err
 	}
 
+	if written > archive.MaxSize {
+		return archive.Invalid("size exceeds %d bytes", archive.MaxSize)
+	}
+
 	mime, err := mimetype.DetectFile(archivePath)
 	if err != nil {
 		return err
@@ -140,32 +163,85 @@ func BootstrapLambda(ctx context.Context, id string, lambda *api.CreateLambda) e
 		return err
 	}
 
-	err = archiver.Unarchive(archivePath, dest)
+	err = archive.Unarchive(archivePath, dest)
 	if err != nil {
 		return err
 	}
 
+	blobsLock.RLock()
+	defer blobsLock.RUnlock()
+
+	files := []api.LambdaManifestFile{}
 	err = filepath.Walk(dest, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
 		if info.IsDir() {
 			return nil
 		}
 
-		_, lerr := minioCli.FPutObject(ctx, lambdaBucket, id+filepath.ToSlash(file)[len(dest):], file, minio.PutObjectOptions{})
+		name := filepath.ToSlash(file)[len(dest)+1:]
 
-		return lerr
+		if info.Mode()&os.ModeSymlink != 0 {
+			// Manifest has no symlinks, so like in the manifest uploaded by cli, the symlink is stored as the file it points to
+			if lerr := archive.CheckLink(dest, name, file); lerr != nil {
+				return lerr
+			}
+		} else if !info.Mode().IsRegular() {
+			return archive.Invalid("%s is not a regular file", name)
+		}
+
+		digest, lerr := putBlob(ctx, file)
+		if lerr != nil {
+			return lerr
+		}
+
+		files = append(files, api.LambdaManifestFile{
+			Path:   name,
+			Digest: digest,
+		})
+
+		return nil
 	})
 
 	if err != nil {
 		return err
 	}
 
+	return putManifest(ctx, id, version, files)
+}
+
+// RemoveLambda removes objects of all lambda versions from the lambda bucket, blobs are left until no manifest references them.
+func RemoveLambda(ctx context.Context, id string) error {
+	objectCh := minioCli.ListObjects(ctx, lambdaBucket, minio.ListObjectsOptions{
+		Prefix:    id + "/",
+		Recursive: true,
+	})
+
+	for rErr := range minioCli.RemoveObjects(ctx, lambdaBucket, objectCh, minio.RemoveObjectsOptions{}) {
+		if rErr.Err != nil {
+			return rErr.Err
+		}
+	}
+
 	return nil
 }
 
-func BootstrapRuntime(ctx context.Context, id string, runtime *api.CreateRuntime) error {
+// runtimeObject returns the Dockerfile object of the runtime revision in the runtime bucket.
+// Revision 0 stands for runtimes created before revisions were introduced.
+func runtimeObject(id string, revision int64) string {
+	if revision == 0 {
+		return id
+	}
+
+	return fmt.Sprintf("%s/%d", id, revision)
+}
+
+func BootstrapRuntime(ctx context.Context, id string, revision int64, runtime *api.CreateRuntime) error {
 	_, err := minioCli.CopyObject(ctx, minio.CopyDestOptions{
 		Bucket:       runtimeBucket,
-		Object:       id,
+		Object:       runtimeObject(id, revision),
 		UserMetadata: map[string]string{"name": runtime.Name}},
 		minio.CopySrcOptions{Bucket: tmpBucket, Object: runtime.Dockerfile})
 	if err != nil {
@@ -175,38 +251,136 @@ func BootstrapRuntime(ctx context.Context, id string, runtime *api.CreateRuntime
 	return nil
 }
 
-func TarLambda(ctx context.Context, lambda string, runtime string) (io.Reader, error) {
-	objectCh := minioCli.ListObjects(ctx, lambdaBucket, minio.ListObjectsOptions{
-		Prefix:    lambda,
+// runtimeBaseObject returns the base image Dockerfile object of the runtime revision in the runtime bucket.
+func runtimeBaseObject(id string, revision int64) string {
+	return fmt.Sprintf("%s/base/%d", id, revision)
+}
+
+// BootstrapRuntimeBase stores uploaded base image Dockerfile of the runtime revision.
+func BootstrapRuntimeBase(ctx context.Context, id string, revision int64, dockerfile string) error {
+	_, err := minioCli.CopyObject(ctx, minio.CopyDestOptions{
+		Bucket: runtimeBucket,
+		Object: runtimeBaseObject(id, revision)},
+		minio.CopySrcOptions{Bucket: tmpBucket, Object: dockerfile})
+
+	return err
+}
+
+// RemoveRuntime removes Dockerfiles of all runtime revisions from the runtime bucket.
+func RemoveRuntime(ctx context.Context, id string) error {
+	objectCh := minioCli.ListObjects(ctx, runtimeBucket, minio.ListObjectsOptions{
+		Prefix:    id + "/",
 		Recursive: true,
 	})
 
+	for rErr := range minioCli.RemoveObjects(ctx, runtimeBucket, objectCh, minio.RemoveObjectsOptions{}) {
+		if rErr.Err != nil {
+			return rErr.Err
+		}
+	}
+
+	return minioCli.RemoveObject(ctx, runtimeBucket, runtimeObject(id, 0), minio.RemoveObjectOptions{})
+}
+
+// TarLambda packs build context of the lambda version: its files assembled from the manifest blobs and the runtime Dockerfile.
+func TarLambda(ctx context.Context, lambda string, version int64, runtime *api.Runtime) (io.Reader, error) {
 	dir, err := os.MkdirTemp("", "doless-lambda-")
 	if err != nil {
 		return nil, err
 	}
 	defer os.RemoveAll(dir)
 
+	files, err := getManifest(ctx, lambda, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if files == nil {
+		err = getLegacyLambda(ctx, lambda, version, dir)
+	} else {
+		err = getBlobs(ctx, files, dir)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	dockerfilePath := path.Join(dir, "Dockerfile")
+	if err := minioCli.FGetObject(ctx, runtimeBucket, runtimeObject(runtime.Id, runtime.Revision), dockerfilePath, minio.GetObjectOptions{}); err != nil {
+		return nil, err
+	}
+
+	return util.Tar(dir)
+}
+
+// getBlobs downloads files of the manifest into dir, identical files are downloaded once.
+func getBlobs(ctx context.Context, files []api.LambdaManifestFile, dir string) error {
+	fetched := map[string]string{}
+
+	for _, file := range files {
+		target := path.Join(dir, file.Path)
+		if err := os.MkdirAll(path.Dir(target), 0777); err != nil {
+			return err
+		}
+
+		if prev, exists := fetched[file.Digest]; exists {
+			if err := os.Link(prev, target); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := minioCli.FGetObject(ctx, blobBucket, file.Digest, target, minio.GetObjectOptions{}); err != nil {
+			return err
+		}
+		fetched[file.Digest] = target
+	}
+
+	return nil
+}
+
+// getLegacyLambda downloads files of the lambda version stored object by object before manifests were introduced.
+func getLegacyLambda(ctx context.Context, lambda string, version int64, dir string) error {
+	prefix := lambdaPrefix(lambda, version)
+	objectCh := minioCli.ListObjects(ctx, lambdaBucket, minio.ListObjectsOptions{
+		Prefix:    prefix,
+		Recursive: true,
+	})
+
 	for object := range objectCh {
 		if object.Err != nil {
-			return nil, object.Err
+			return object.Err
 		}
 
 		oPath := object.Key
-		aPath := strings.Join(strings.Split(oPath, "/")[1:], "/")
+		aPath := strings.TrimPrefix(oPath, prefix)
+		if strings.HasSuffix(aPath, manifestSuffix) {
+			// Manifests of newer versions share the prefix of lambdas created before versioning was introduced
+			continue
+		}
+
 		fileDir := path.Join(dir, path.Dir(aPath))
 		if err := os.MkdirAll(fileDir, 0777); err != nil {
-			os.RemoveAll(dir)
-			return nil, err
+			return err
 		}
 
 		if err := minioCli.FGetObject(ctx, lambdaBucket, oPath, path.Join(dir, aPath), minio.GetObjectOptions{}); err != nil {
-			return nil, err
+			return err
 		}
 	}
 
-	dockerfilePath := path.Join(dir, "Dockerfile")
-	if err := minioCli.FGetObject(ctx, runtimeBucket, runtime, dockerfilePath, minio.GetObjectOptions{}); err != nil {
+	return nil
+}
+
+// TarRuntimeBase packs base image Dockerfile of the runtime revision, it is the only file of the build context.
+func TarRuntimeBase(ctx context.Context, id string, revision int64) (io.Reader, error) {
+	dir, err := os.MkdirTemp("", "doless-runtime-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	if err := minioCli.FGetObject(ctx, runtimeBucket, runtimeBaseObject(id, revision), path.Join(dir, "Dockerfile"), minio.GetObjectOptions{}); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,315 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	api "github.com/hedlx/doless/client"
+	"github.com/hedlx/doless/manager/docker"
+	"github.com/hedlx/doless/manager/logger"
+	"github.com/hedlx/doless/manager/model"
+	"github.com/hedlx/doless/manager/util"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+// reconcileInterval is how often docker state is converged to lambda records.
+var reconcileInterval = time.Duration(util.GetIntVarDefault("RECONCILE_INTERVAL", 30)) * time.Second
+
+func (s service) reconcileRoutine(ctx context.Context) {
+	for {
+		select {
+		case <-time.After(reconcileInterval):
+		case <-ctx.Done():
+			return
+		}
+
+		if err := s.reconcile(ctx, false); err != nil {
+			logger.L.Error("Failed to reconcile lambdas", zap.Error(err))
+		}
+	}
+}
+
+// reconcile converges docker state to lambda records: missing containers and images of the lambdas
+// are brought back, while containers and images which belong to no lambda are removed.
+// Exited containers are restarted by docker according to the lambda restart policy, so they are started
+// only with startExited, once the manager which stopped them on shutdown starts again.
+func (s service) reconcile(ctx context.Context, startExited bool) error {
+	// Lambdas are rebuilt from runtime base images, so they are brought back first
+	if err := s.reconcileRuntimes(ctx, startExited); err != nil {
+		return err
+	}
+
+	// Docker state is listed before lambdas, so objects created in between are never taken for orphans
+	containers, err := s.dockerSvc.ListContainers(ctx)
+	if err != nil {
+		return err
+	}
+
+	images, err := s.dockerSvc.ListImages(ctx)
+	if err != nil {
+		return err
+	}
+
+	lambdas, err := GetLambdas(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, lambda := range lambdas {
+		if err := s.reconcileLambda(ctx, lambda.Id, startExited); err != nil {
+			logger.L.Error(
+				"Failed to reconcile lambda",
+				zap.Error(err),
+				zap.String("id", lambda.Id),
+			)
+			reportEvent(ctx, lambda.Id, ReconcileFailedEvent, err.Error())
+		}
+	}
+
+	for _, container := range containers {
+		owned := func(lambda *api.Lambda) bool {
+			_, exists := lo.Find(lambda.Docker.Replicas, func(r api.Replica) bool { return r.ContainerId == container.ID })
+			return exists
+		}
+
+		id, labelled := container.Labels[docker.LambdaLabel]
+		if !labelled {
+			// Containers created before the label was introduced are never created anymore,
+			// so it's enough to match them against the listed lambdas
+			if _, exists := lo.Find(lambdas, owned); exists {
+				continue
+			}
+		}
+
+		s.removeOrphan(ctx, id, "container "+container.ID, owned, func() error {
+			return s.dockerSvc.RemoveContainer(ctx, container.ID)
+		})
+	}
+
+	for _, image := range images {
+		for _, tag := range image.RepoTags {
+			name, _, found := strings.Cut(tag, ":")
+			if !found || name == "<none>" {
+				continue
+			}
+
+			if strings.HasPrefix(name, RuntimeImagePrefix) {
+				s.removeOrphanBase(ctx, tag)
+				continue
+			}
+
+			// Lambda images are tagged by lambda name, which is its id
+			s.removeOrphan(ctx, name, "image "+tag, func(lambda *api.Lambda) bool {
+				return lambda.Docker.GetImage() == tag
+			}, func() error {
+				return s.dockerSvc.RemoveImage(ctx, tag)
+			})
+		}
+	}
+
+	return nil
+}
+
+// reconcileLambda recreates externally deleted lambda containers, starts exited containers of the running
+// lambda if asked and rebuilds the lambda once its image is gone. Lambdas being processed are reconciled next time.
+func (s service) reconcileLambda(ctx context.Context, id string, startExited bool) error {
+	if succ := s.starting.AddUniq(id); !succ {
+		return nil
+	}
+	defer s.starting.Remove(id)
+
+	lambda, err := GetLambda(ctx, id)
+	if err != nil || lambda == nil {
+		return err
+	}
+
+	if migrated := migrateDocker(lambda); migrateState(lambda) || migrated {
+		if err := s.updateLambda(ctx, *lambda); err != nil {
+			return err
+		}
+	}
+
+	if state := lambdaState(lambda); state == model.BuildingState || state == model.DestroyingState {
+		// The lock is held, so the operation was interrupted by manager restart
+		transition(lambda, settledState(lambda), "operation is interrupted by manager restart")
+		if err := s.updateLambda(ctx, *lambda); err != nil {
+			return err
+		}
+	}
+
+	if !deployed(lambda) {
+		return nil
+	}
+
+	exists, err := s.dockerSvc.ImageExists(ctx, *lambda.Docker.Image)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		image := lambda.Docker.GetImage()
+		if err := s.rebuild(ctx, lambda, nil); err != nil {
+			return err
+		}
+
+		reportEvent(ctx, id, ImageRebuiltEvent, fmt.Sprintf("image %s is missing, rebuilt", image))
+		return nil
+	}
+
+	changed := false
+
+	for i, replica := range lambda.Docker.Replicas {
+		info, err := s.dockerSvc.Inspect(ctx, replica.ContainerId)
+		if err != nil && !docker.IsNotFound(err) {
+			return err
+		}
+
+		if err != nil {
+			containerID, err := s.recreateReplica(ctx, lambda, replica)
+			if err != nil {
+				return err
+			}
+
+			lambda.Docker.Replicas[i].ContainerId = containerID
+			changed = true
+
+			reportEvent(ctx, id, ContainerRecreatedEvent, fmt.Sprintf("container %s is deleted externally, recreated", replica.Container))
+			continue
+		}
+
+		if startExited {
+			// Containers created before restart policies were introduced get the policy as well
+			if err := s.dockerSvc.UpdateRestartPolicy(ctx, replica.ContainerId, model.WithDefaultRestartPolicy(lambda.RestartPolicy)); err != nil {
+				return err
+			}
+		}
+
+		if !startExited || stopped(lambda) || info.State.Running || info.State.Restarting {
+			continue
+		}
+
+		if err := s.dockerSvc.Start(ctx, replica.ContainerId); err != nil {
+			return err
+		}
+
+		reportEvent(ctx, id, ContainerRestartedEvent, fmt.Sprintf("container %s is %s, restarted", replica.Container, info.State.Status))
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return s.updateLambda(ctx, *lambda)
+}
+
+// recreateReplica creates container of the replica from the lambda image, it is started unless lambda is stopped.
+func (s service) recreateReplica(ctx context.Context, lambda *api.Lambda, replica api.Replica) (string, error) {
+	opts, err := s.containerOptions(ctx, lambda)
+	if err != nil {
+		return "", err
+	}
+
+	id, err := s.dockerSvc.CreateContainer(ctx, *lambda.Docker.Image, replica.Container, opts, docker.NetworkAliases(lambda))
+	if err != nil {
+		return "", err
+	}
+
+	if stopped(lambda) {
+		return id, nil
+	}
+
+	if err := s.dockerSvc.Start(ctx, id); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// rebuild deploys the lambda from scratch, e.g. once its image is gone. Stopped lambda is stopped again after the build.
+func (s service) rebuild(ctx context.Context, lambda *api.Lambda, progress docker.BuildProgress) error {
+	state := lambdaState(lambda)
+	reason := lambda.GetStatusReason()
+	wasStopped := stopped(lambda)
+
+	// Old containers and image are replaced anyway, failures are logged
+	if err := s.teardown(ctx, lambda); err != nil && !docker.IsNotFound(err) {
+		logger.L.Error(
+			"Failed to remove rebuilt containers",
+			zap.Error(err),
+			zap.String("lambda", lambda.Id),
+		)
+	}
+	lambda.Docker = api.Docker{}
+
+	if err := s.launch(ctx, lambda, progress); err != nil {
+		// Lambda is left undeployed by launch, so the next start builds it
+		return err
+	}
+
+	if wasStopped {
+		if err := s.stopReplicas(ctx, lambda.Docker.Replicas); err != nil {
+			return err
+		}
+
+		setStatus(lambda, ExitedStatus)
+
+		if err := transition(lambda, state, reason); err != nil {
+			return err
+		}
+	}
+
+	if err := s.updateLambda(ctx, *lambda); err != nil {
+		return err
+	}
+
+	if !wasStopped {
+		s.resyncLambda(ctx, lambda.Id)
+	}
+
+	return nil
+}
+
+// removeOrphan removes docker object unless the record of the lambda it belongs to references it.
+// Lambda lock is taken, so objects of the lambda being processed are left as is.
+func (s service) removeOrphan(ctx context.Context, id string, object string, owned func(lambda *api.Lambda) bool, remove func() error) {
+	var lambda *api.Lambda
+
+	if id != "" {
+		if succ := s.starting.AddUniq(id); !succ {
+			return
+		}
+		defer s.starting.Remove(id)
+
+		var err error
+		if lambda, err = GetLambda(ctx, id); err != nil {
+			logger.L.Error(
+				"Failed to gather lambda",
+				zap.Error(err),
+				zap.String("id", id),
+			)
+			return
+		}
+
+		if lambda != nil && owned(lambda) {
+			return
+		}
+	}
+
+	if err := remove(); err != nil {
+		logger.L.Error(
+			"Failed to remove orphan",
+			zap.Error(err),
+			zap.String("object", object),
+		)
+		return
+	}
+
+	logger.L.Info("Removed orphan", zap.String("object", object), zap.String("lambda", id))
+
+	if lambda != nil {
+		reportEvent(ctx, id, OrphanRemovedEvent, object+" doesn't belong to the lambda, removed")
+	}
+}
@@ -0,0 +1,306 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	api "github.com/hedlx/doless/client"
+	"github.com/hedlx/doless/manager/docker"
+	"github.com/hedlx/doless/manager/logger"
+	"github.com/hedlx/doless/manager/util"
+	"go.uber.org/zap"
+)
+
+// DegradedStatus marks lambdas whose replicas don't share the same status.
+const DegradedStatus = "degraded"
+
+// ExitedStatus is docker status of stopped containers.
+const ExitedStatus = "exited"
+
+// ReconnectingStatus marks the replica which is taken out of the handler balancer while its network aliases are updated.
+const ReconnectingStatus = "reconnecting"
+
+// rollbackTimeout limits how long partial work of a failed or cancelled operation is undone.
+const rollbackTimeout = 5 * time.Minute
+
+// drainPeriod is how long replaced containers keep serving requests after the new ones are made live.
+var drainPeriod = time.Duration(util.GetIntVarDefault("ROLLOUT_DRAIN_PERIOD", 5)) * time.Second
+
+// rollbackContext is detached from the operation context, so partial work is undone even after it is cancelled.
+func rollbackContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), rollbackTimeout)
+}
+
+// deployed reports whether lambda has containers.
+func deployed(lambda *api.Lambda) bool {
+	return len(lambda.Docker.Replicas) > 0
+}
+
+// replicaCount returns desired number of lambda containers.
+func replicaCount(lambda *api.Lambda) int {
+	if lambda.Replicas == nil || *lambda.Replicas < 1 {
+		return 1
+	}
+
+	return int(*lambda.Replicas)
+}
+
+// containerName derives container name from the image, so containers of the rebuilt lambda don't clash with the old ones.
+func containerName(image string, replica int) string {
+	return fmt.Sprintf("doless-%s-%d", strings.ReplaceAll(image, ":", "-"), replica)
+}
+
+// migrateDocker converts single container model of lambdas created before replicas were introduced.
+func migrateDocker(lambda *api.Lambda) bool {
+	if lambda.Docker.ContainerId == nil || len(lambda.Docker.Replicas) > 0 {
+		return false
+	}
+
+	lambda.Docker.Replicas = []api.Replica{{
+		Container:   lambda.Docker.GetContainer(),
+		ContainerId: *lambda.Docker.ContainerId,
+		Address:     lambda.Docker.GetContainer(),
+		Status:      lambda.Docker.Status,
+	}}
+	lambda.Docker.Container = nil
+	lambda.Docker.ContainerId = nil
+
+	return true
+}
+
+// createReplicas creates containers with indexes in [from, to) from the lambda image.
+// Already created containers are removed if any of them fails.
+func (s service) createReplicas(ctx context.Context, lambda *api.Lambda, opts docker.ContainerOptions, from int, to int, aliases []string) ([]api.Replica, error) {
+	replicas := []api.Replica{}
+
+	for i := from; i < to; i++ {
+		name := containerName(*lambda.Docker.Image, i)
+		id, err := s.dockerSvc.CreateContainer(ctx, *lambda.Docker.Image, name, opts, aliases)
+		if err != nil {
+			rctx, cancel := rollbackContext()
+			defer cancel()
+
+			s.removeReplicas(rctx, replicas)
+			return nil, err
+		}
+
+		replicas = append(replicas, api.Replica{
+			Container:   name,
+			ContainerId: id,
+			Address:     name,
+		})
+	}
+
+	return replicas, nil
+}
+
+func (s service) startReplicas(ctx context.Context, replicas []api.Replica) error {
+	for _, replica := range replicas {
+		if err := s.dockerSvc.Start(ctx, replica.ContainerId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s service) stopReplicas(ctx context.Context, replicas []api.Replica) error {
+	for _, replica := range replicas {
+		if err := s.dockerSvc.Stop(ctx, replica.ContainerId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// promoteReplicas starts standby replicas and exposes them by lambda network aliases once they're healthy.
+func (s service) promoteReplicas(ctx context.Context, lambda *api.Lambda, replicas []api.Replica) error {
+	if err := s.startReplicas(ctx, replicas); err != nil {
+		return err
+	}
+
+	hctx, cancel := context.WithTimeout(ctx, healthyTimeout)
+	defer cancel()
+
+	for _, replica := range replicas {
+		if err := s.dockerSvc.WaitHealthy(hctx, replica.ContainerId); err != nil {
+			return fmt.Errorf("new container failed to become healthy: %w", err)
+		}
+	}
+
+	return s.updateReplicasNetwork(ctx, lambda, replicas)
+}
+
+func (s service) updateReplicasNetwork(ctx context.Context, lambda *api.Lambda, replicas []api.Replica) error {
+	aliases := docker.NetworkAliases(lambda)
+
+	for _, replica := range replicas {
+		if err := s.dockerSvc.UpdateNetwork(ctx, replica.ContainerId, aliases); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconnectReplicas updates network aliases of running replicas. Docker sets aliases only on connect,
+// so replicas are reconnected one by one, each of them is drained first while the rest keep serving requests.
+func (s service) reconnectReplicas(ctx context.Context, lambda *api.Lambda) error {
+	aliases := docker.NetworkAliases(lambda)
+	// The only replica has no other one to take over its requests
+	drain := !stopped(lambda) && len(lambda.Docker.Replicas) > 1
+
+	for _, replica := range lambda.Docker.Replicas {
+		if !drain {
+			if err := s.dockerSvc.UpdateNetwork(ctx, replica.ContainerId, aliases); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := s.reconnectReplica(ctx, lambda.Id, replica.ContainerId, aliases); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconnectReplica takes the replica out of the handler balancer, waits until it is drained and reconnects it.
+func (s service) reconnectReplica(ctx context.Context, id string, containerID string, aliases []string) error {
+	err := s.updateReplica(ctx, id, containerID, func(_ *api.Lambda, replica *api.Replica) {
+		replica.Status = ReconnectingStatus
+	})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		rctx, cancel := rollbackContext()
+		defer cancel()
+
+		// Inspected status returns the replica to the balancer
+		s.resyncReplica(rctx, id, containerID)
+	}()
+
+	select {
+	case <-time.After(drainPeriod):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return s.dockerSvc.UpdateNetwork(ctx, containerID, aliases)
+}
+
+// removeReplicas removes containers and returns the first error, so the rest are removed anyway.
+func (s service) removeReplicas(ctx context.Context, replicas []api.Replica) error {
+	var removeErr error
+
+	for _, replica := range replicas {
+		if err := s.dockerSvc.RemoveContainer(ctx, replica.ContainerId); err != nil {
+			logger.L.Error(
+				"Failed to remove container",
+				zap.Error(err),
+				zap.String("container_id", replica.ContainerId),
+			)
+
+			if removeErr == nil {
+				removeErr = err
+			}
+		}
+	}
+
+	return removeErr
+}
+
+// teardown removes all lambda containers together with the image.
+func (s service) teardown(ctx context.Context, lambda *api.Lambda) error {
+	if err := s.removeReplicas(ctx, lambda.Docker.Replicas); err != nil {
+		return err
+	}
+
+	if lambda.Docker.Image == nil {
+		return nil
+	}
+
+	return s.dockerSvc.RemoveImage(ctx, *lambda.Docker.Image)
+}
+
+// replicaState is the state of the replica container.
+type replicaState struct {
+	Status       string
+	RestartCount int64
+	// Exited is set while container is stopped or waits for restart, exit details are known then
+	Exited    bool
+	ExitCode  int64
+	OOMKilled bool
+}
+
+// replicaStatus reports container health, falling back to its state when there is no healthcheck.
+func (s service) replicaStatus(ctx context.Context, id string) string {
+	return s.inspectReplica(ctx, id).Status
+}
+
+func (s service) inspectReplica(ctx context.Context, id string) replicaState {
+	container, err := s.dockerSvc.Inspect(ctx, id)
+	if err != nil {
+		if docker.IsNotFound(err) {
+			// Externally deleted containers are recreated by the reconciler
+			return replicaState{Status: RemovedStatus}
+		}
+
+		logger.L.Error(
+			"Failed to inspect container",
+			zap.Error(err),
+			zap.String("container_id", id),
+		)
+		return replicaState{Status: "error"}
+	}
+
+	state := replicaState{
+		Status:       container.State.Status,
+		RestartCount: int64(container.RestartCount),
+		Exited:       !container.State.Running || container.State.Restarting,
+		ExitCode:     int64(container.State.ExitCode),
+		OOMKilled:    container.State.OOMKilled,
+	}
+
+	if state.Exited {
+		// Unlike stopped on purpose, exited containers are restarted according to the restart policy
+		return state
+	}
+
+	if container.State.Health != nil {
+		state.Status = container.State.Health.Status
+	}
+
+	return state
+}
+
+// aggregateStatus returns the status shared by all replicas.
+func aggregateStatus(replicas []api.Replica) string {
+	if len(replicas) == 0 {
+		return ""
+	}
+
+	for _, replica := range replicas[1:] {
+		if replica.Status != replicas[0].Status {
+			return DegradedStatus
+		}
+	}
+
+	return replicas[0].Status
+}
+
+// setStatus sets the status to all lambda replicas, e.g. once they are stopped.
+func setStatus(lambda *api.Lambda, status string) {
+	for i := range lambda.Docker.Replicas {
+		lambda.Docker.Replicas[i].Status = status
+	}
+
+	lambda.Docker.Status = status
+}
@@ -0,0 +1,57 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	api "github.com/hedlx/doless/client"
+	"github.com/hedlx/doless/manager/model"
+)
+
+// CrashLoopStatus marks replicas whose container keeps failing after all restarts allowed by the restart policy.
+const CrashLoopStatus = "CRASH_LOOP"
+
+// CrashLoopEvent is reported when lambda container exhausts its restarts.
+const CrashLoopEvent = "CrashLoop"
+
+// crashLooping reports whether docker gave up restarting the exited on-failure container.
+func crashLooping(lambda *api.Lambda, state replicaState) bool {
+	policy := model.WithDefaultRestartPolicy(lambda.RestartPolicy)
+
+	return state.Status == ExitedStatus && state.ExitCode != 0 && policy.Mode == model.RestartOnFailure &&
+		policy.GetMaxRetries() > 0 && state.RestartCount >= policy.GetMaxRetries()
+}
+
+// SetRestartPolicy changes restart policy of the lambda and applies it to the existing containers, nil resets it to the default.
+func (s service) SetRestartPolicy(ctx context.Context, id string, policy *api.LambdaRestartPolicy) (*api.Lambda, error) {
+	if succ := s.starting.AddUniq(id); !succ {
+		return nil, fmt.Errorf("lambda '%s' is already being processed", id)
+	}
+	defer s.starting.Remove(id)
+
+	lambda, err := GetLambda(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if lambda == nil {
+		return nil, errors.New("not found")
+	}
+
+	lambda.RestartPolicy = policy
+	lambda.UpdatedAt = time.Now().UnixMilli()
+
+	for _, replica := range lambda.Docker.Replicas {
+		if err := s.dockerSvc.UpdateRestartPolicy(ctx, replica.ContainerId, model.WithDefaultRestartPolicy(policy)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.updateLambda(ctx, *lambda); err != nil {
+		return nil, err
+	}
+
+	return lambda, nil
+}
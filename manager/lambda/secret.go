@@ -0,0 +1,47 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/hedlx/doless/manager/db"
+	"github.com/hedlx/doless/manager/secret"
+)
+
+// secretKey encrypts secret env values, it's loaded once the service is created.
+var secretKey secret.Key
+
+// loadSecretKey derives secretKey from the master key, manager doesn't start without it.
+func loadSecretKey() error {
+	master := os.Getenv("SECRET_KEY")
+	if master == "" {
+		return errors.New("SECRET_KEY env var is missing, it's required to encrypt secret env values of lambdas")
+	}
+
+	secretKey = secret.NewKey(master)
+
+	return nil
+}
+
+// GetSecrets returns encrypted secret env values of the lambda by their names.
+func GetSecrets(ctx context.Context, lambda string) (map[string]string, error) {
+	secrets, err := db.GetValue[map[string]string](ctx, "secret", lambda)
+	if err != nil {
+		return nil, err
+	}
+
+	if secrets == nil {
+		return map[string]string{}, nil
+	}
+
+	return *secrets, nil
+}
+
+func SetSecrets(ctx context.Context, lambda string, secrets map[string]string) error {
+	return db.SetValue(ctx, "secret:"+lambda, secrets)
+}
+
+func DeleteSecrets(ctx context.Context, lambda string) error {
+	return db.DelValue(ctx, "secret:"+lambda)
+}
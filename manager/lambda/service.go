@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 	"time"
 
 	api "github.com/hedlx/doless/client"
@@ -11,6 +13,7 @@ import (
 	"github.com/hedlx/doless/manager/db"
 	"github.com/hedlx/doless/manager/docker"
 	"github.com/hedlx/doless/manager/logger"
+	"github.com/hedlx/doless/manager/model"
 	"github.com/hedlx/doless/manager/util"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
@@ -21,19 +24,55 @@ type service struct {
 	bootstrapping common.ConcurrentSet[string]
 	starting      common.ConcurrentSet[string]
 	lambdas       common.ConcurrentMap[string, api.Lambda]
-	inspect       common.ConcurrentMap[string, func()]
+	scaledAt      common.ConcurrentMap[string, time.Time]
+	// runtimes locks runtimes by id, while bootstrapping locks uploaded archives
+	runtimes common.ConcurrentSet[string]
+	// stopRoutines stops background routines
+	stopRoutines context.CancelFunc
 }
 
 type LambdaService interface {
 	Init() error
 	Stop(ctx context.Context)
 	BootstrapRuntime(ctx context.Context, runtime *api.CreateRuntime) (*api.Runtime, error)
+	UpdateRuntime(ctx context.Context, id string, req *api.UpdateRuntime) (*api.Runtime, error)
+	DeleteRuntime(ctx context.Context, id string) error
+	BuildRuntimeBase(ctx context.Context, id string, progress docker.BuildProgress) error
+	RolloutRuntime(ctx context.Context, id string, batch int, progress docker.BuildProgress) error
 	BootstrapLambda(ctx context.Context, lambda *api.CreateLambda) (*api.Lambda, error)
-	Start(ctx context.Context, id string) error
+	CreateVersion(ctx context.Context, id string, cVersion *api.CreateLambdaVersion) (*api.LambdaVersion, error)
+	SetAlias(ctx context.Context, id string, alias string, version int64) (*api.Lambda, error)
+	SetEnv(ctx context.Context, id string, env []api.LambdaEnvVar) error
+	DeleteAlias(ctx context.Context, id string, alias string) (*api.Lambda, error)
+	UpdateCode(ctx context.Context, id string, req *api.UpdateLambdaCode, progress docker.BuildProgress) error
+	Start(ctx context.Context, id string, progress docker.BuildProgress) error
+	StopLambda(ctx context.Context, id string) error
+	Scale(ctx context.Context, id string, replicas int64) error
+	Wake(ctx context.Context, id string) error
+	SetIdleTimeout(ctx context.Context, id string, timeout int64) (*api.Lambda, error)
+	SetAutoscaling(ctx context.Context, id string, as *api.LambdaAutoscaling) (*api.Lambda, error)
+	SetRestartPolicy(ctx context.Context, id string, policy *api.LambdaRestartPolicy) (*api.Lambda, error)
+	Logs(ctx context.Context, id string, replica *int64, opts docker.LogsOptions, emit func(api.LambdaLogLine)) error
 	Destroy(ctx context.Context, id string) error
+	Delete(ctx context.Context, id string, cascade bool) error
 }
 
+// LiveAlias is the alias whose version is deployed on lambda start.
+// Lambdas without it run their latest version.
+const LiveAlias = "live"
+
+// healthyTimeout limits how long updated lambda container may stay unhealthy before rollback.
+const healthyTimeout = 2 * time.Minute
+
 func CreateLambdaService() (LambdaService, error) {
+	if err := loadSecretKey(); err != nil {
+		return nil, err
+	}
+
+	if err := connectMinio(); err != nil {
+		return nil, err
+	}
+
 	dockerSvc, err := docker.NewDockerService(db.DolessID)
 
 	if err != nil {
@@ -43,15 +82,24 @@ func CreateLambdaService() (LambdaService, error) {
 	svc := &service{
 		dockerSvc:     dockerSvc,
 		bootstrapping: common.CreateConcurrentSet[string](),
+		runtimes:      common.CreateConcurrentSet[string](),
 		starting:      common.CreateConcurrentSet[string](),
 		lambdas:       common.CreateConcurrentMap[string, api.Lambda](),
-		inspect:       common.CreateConcurrentMap[string, func()](),
+		scaledAt:      common.CreateConcurrentMap[string, time.Time](),
 	}
 
 	if err := svc.Init(); err != nil {
 		return nil, err
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
+	svc.stopRoutines = cancel
+	go svc.eventsRoutine(ctx)
+	go svc.resyncRoutine(ctx)
+	go svc.controlRoutine(ctx)
+	go svc.reconcileRoutine(ctx)
+	go svc.blobsRoutine(ctx)
+
 	return svc, nil
 }
 
@@ -67,63 +115,23 @@ func (s service) Init() error {
 		s.lambdas.Set(lambda.Id, *lambda)
 	}
 
-	var lambdaInitErr error
-	lo.ForEach(s.lambdas.Values(), func(lambda api.Lambda, _ int) {
-		if lambda.Docker.ContainerId == nil {
-			return
-		}
-
-		_, err := s.dockerSvc.Inspect(ctx, *lambda.Docker.ContainerId)
-
-		// TODO: check error more precisely and handle correctly
-		if err != nil {
-			id, err := s.dockerSvc.CreateContainer(ctx, &lambda)
-			if err != nil {
-				id, lambdaInitErr = s.start(ctx, &lambda)
-			}
-
-			if id != "" {
-				lambda.Docker.ContainerId = &id
-				s.updateLambda(ctx, lambda)
-			}
-		} else if err := s.dockerSvc.Start(ctx, &lambda); err != nil {
-			logger.L.Error(
-				"failed to start lambda",
-				zap.Error(err),
-				zap.String("lambda", lambda.Id),
-				zap.String("container_id", *lambda.Docker.ContainerId),
-			)
-		}
-	})
-
-	if lambdaInitErr != nil {
-		return lambdaInitErr
+	// Containers stopped on manager shutdown are started again, the missing ones are recreated
+	if err := s.reconcile(ctx, true); err != nil {
+		return err
 	}
 
-	s.lambdas.ForEach(func(_ string, lambda api.Lambda) {
-		if lambda.Docker.ContainerId == nil {
-			return
-		}
-
-		lctx, cancel := context.WithCancel(context.Background())
-		s.inspect.Set(lambda.Id, cancel)
-		go s.inspectRoutine(lctx, lambda)
-	})
+	s.resync(ctx)
 
 	return nil
 }
 
 func (s *service) Stop(ctx context.Context) {
-	s.inspect.ForEach(func(_ string, stop func()) {
-		stop()
-	})
+	s.stopRoutines()
 
 	s.lambdas.ForEach(func(_ string, lambda api.Lambda) {
-		if lambda.Docker.ContainerId == nil {
-			return
+		for _, replica := range lambda.Docker.Replicas {
+			s.dockerSvc.Stop(ctx, replica.ContainerId)
 		}
-
-		s.dockerSvc.Stop(ctx, &lambda)
 	})
 }
 
@@ -135,17 +143,32 @@ func (s *service) BootstrapRuntime(ctx context.Context, cRuntime *api.CreateRunt
 
 	id := util.UUID()
 
-	if err := BootstrapRuntime(ctx, id, cRuntime); err != nil {
+	var revision int64 = 1
+	if err := BootstrapRuntime(ctx, id, revision, cRuntime); err != nil {
 		return nil, err
 	}
 
+	var base *api.RuntimeBaseImage
+	if cRuntime.BaseDockerfile != nil {
+		if err := BootstrapRuntimeBase(ctx, id, revision, *cRuntime.BaseDockerfile); err != nil {
+			return nil, err
+		}
+
+		base = pendingBase(id, revision)
+	}
+
 	createdAt := time.Now().UnixMilli()
 
 	runtime := &api.Runtime{
-		Id:        id,
-		Name:      cRuntime.Name,
-		CreatedAt: createdAt,
-		UpdatedAt: createdAt,
+		Id:          id,
+		Name:        cRuntime.Name,
+		Healthcheck: cRuntime.Healthcheck,
+		Parameters:  cRuntime.Parameters,
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+		Revision:    revision,
+		Revisions:   []api.RuntimeRevision{{Revision: revision, CreatedAt: createdAt}},
+		BaseImage:   base,
 	}
 
 	if err := SetRuntime(ctx, runtime); err != nil {
@@ -155,12 +178,112 @@ func (s *service) BootstrapRuntime(ctx context.Context, cRuntime *api.CreateRunt
 	return runtime, nil
 }
 
-func (s *service) BootstrapLambda(ctx context.Context, cLambda *api.CreateLambda) (*api.Lambda, error) {
-	if succ := s.bootstrapping.AddUniq(cLambda.Archive); !succ {
-		return nil, fmt.Errorf("lambda with '%s' archive is already being bootstrapped", cLambda.Archive)
+// UpdateRuntime stores new Dockerfile as the next runtime revision.
+// Lambdas pick it up on their next build.
+func (s *service) UpdateRuntime(ctx context.Context, id string, req *api.UpdateRuntime) (*api.Runtime, error) {
+	if succ := s.runtimes.AddUniq(id); !succ {
+		return nil, fmt.Errorf("runtime '%s' is already being processed", id)
+	}
+	defer s.runtimes.Remove(id)
+
+	runtime, err := GetRuntime(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if runtime == nil {
+		return nil, errors.New("not found")
+	}
+
+	if len(runtime.Revisions) == 0 {
+		// Runtime was created before revisions were introduced
+		runtime.Revisions = []api.RuntimeRevision{{Revision: runtime.Revision, CreatedAt: runtime.CreatedAt}}
+	}
+
+	revision := api.RuntimeRevision{
+		Revision:  runtime.Revision + 1,
+		CreatedAt: time.Now().UnixMilli(),
+	}
+
+	if err := BootstrapRuntime(ctx, id, revision.Revision, &api.CreateRuntime{Name: runtime.Name, Dockerfile: req.Dockerfile}); err != nil {
+		return nil, err
+	}
+
+	// Base image of the previous revision is kept unless the new one is uploaded
+	if req.BaseDockerfile != nil {
+		if err := BootstrapRuntimeBase(ctx, id, revision.Revision, *req.BaseDockerfile); err != nil {
+			return nil, err
+		}
+
+		runtime.BaseImage = pendingBase(id, revision.Revision)
+	}
+
+	runtime.Revision = revision.Revision
+	runtime.Revisions = append(runtime.Revisions, revision)
+	runtime.UpdatedAt = revision.CreatedAt
+
+	if req.Healthcheck != nil {
+		runtime.Healthcheck = req.Healthcheck
+	}
+
+	if req.Parameters != nil {
+		runtime.Parameters = req.Parameters
+	}
+
+	if err := SetRuntime(ctx, runtime); err != nil {
+		return nil, err
+	}
+
+	return runtime, nil
+}
+
+func (s *service) DeleteRuntime(ctx context.Context, id string) error {
+	if succ := s.runtimes.AddUniq(id); !succ {
+		return fmt.Errorf("runtime '%s' is already being processed", id)
+	}
+	defer s.runtimes.Remove(id)
+
+	runtime, err := GetRuntime(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if runtime == nil {
+		return errors.New("not found")
+	}
+
+	lambdas, err := GetRuntimeLambdas(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if len(lambdas) > 0 {
+		ids := lo.Map(lambdas, func(l *api.Lambda, _ int) string { return l.Id })
+		return fmt.Errorf("runtime is used by lambdas: %s", strings.Join(ids, ", "))
+	}
+
+	if err := RemoveRuntime(ctx, id); err != nil {
+		return err
+	}
+
+	return DeleteRuntime(ctx, id)
+}
+
+// bootstrapSources stores sources of the lambda version passed either as the uploaded archive or as the manifest.
+func (s service) bootstrapSources(ctx context.Context, id string, version int64, archive *string, manifest []api.LambdaManifestFile) error {
+	if archive == nil {
+		return BootstrapManifest(ctx, id, version, manifest)
+	}
+
+	if succ := s.bootstrapping.AddUniq(*archive); !succ {
+		return fmt.Errorf("lambda with '%s' archive is already being bootstrapped", *archive)
 	}
-	defer s.bootstrapping.Remove(cLambda.Archive)
+	defer s.bootstrapping.Remove(*archive)
+
+	return BootstrapLambda(ctx, id, version, *archive)
+}
 
+func (s *service) BootstrapLambda(ctx context.Context, cLambda *api.CreateLambda) (*api.Lambda, error) {
 	existing, err := GetLambda(ctx, cLambda.Name)
 	if err != nil {
 		return nil, err
@@ -176,19 +299,47 @@ func (s *service) BootstrapLambda(ctx context.Context, cLambda *api.CreateLambda
 		return nil, errors.New("not found")
 	}
 
-	if err := BootstrapLambda(ctx, cLambda.Name, cLambda); err != nil {
+	resources := model.WithDefaultResources(cLambda.Resources)
+
+	replicas := cLambda.Replicas
+	autoscaling := cLambda.Autoscaling
+	if autoscaling != nil {
+		withDefaults := model.WithDefaultAutoscaling(*autoscaling)
+		autoscaling = &withDefaults
+
+		if replicas == nil {
+			// Autoscaled lambda starts with the least replicas
+			replicas = &autoscaling.MinReplicas
+		}
+	}
+
+	var version int64 = 1
+	if err := s.bootstrapSources(ctx, cLambda.Name, version, cLambda.Archive, cLambda.Manifest); err != nil {
 		return nil, err
 	}
 
 	createdAt := time.Now().UnixMilli()
 
 	lambda := api.Lambda{
-		Id:         cLambda.Name,
-		Name:       cLambda.Name,
-		CreatedAt:  createdAt,
-		UpdatedAt:  createdAt,
-		Runtime:    cLambda.Runtime,
-		LambdaType: cLambda.LambdaType,
+		Id:            cLambda.Name,
+		Name:          cLambda.Name,
+		CreatedAt:     createdAt,
+		UpdatedAt:     createdAt,
+		Runtime:       cLambda.Runtime,
+		LambdaType:    cLambda.LambdaType,
+		Resources:     &resources,
+		Replicas:      replicas,
+		IdleTimeout:   cLambda.IdleTimeout,
+		Autoscaling:   autoscaling,
+		RestartPolicy: cLambda.RestartPolicy,
+		BuildArgs:     cLambda.BuildArgs,
+		Version:       version,
+		Versions:      []api.LambdaVersion{{Version: version, CreatedAt: createdAt}},
+		Aliases:       []api.LambdaAlias{},
+	}
+
+	if err := transition(&lambda, model.PendingState, "lambda is created"); err != nil {
+		return nil, err
 	}
 
 	if err := SetLambda(ctx, &lambda); err != nil {
@@ -200,58 +351,147 @@ func (s *service) BootstrapLambda(ctx context.Context, cLambda *api.CreateLambda
 	return &lambda, nil
 }
 
-func (s service) start(ctx context.Context, lambda *api.Lambda) (string, error) {
-	tar, err := TarLambda(ctx, lambda.Id, lambda.Runtime)
+func (s *service) CreateVersion(ctx context.Context, id string, cVersion *api.CreateLambdaVersion) (*api.LambdaVersion, error) {
+	if succ := s.starting.AddUniq(id); !succ {
+		return nil, fmt.Errorf("lambda '%s' is already being processed", id)
+	}
+	defer s.starting.Remove(id)
+
+	lambda, err := GetLambda(ctx, id)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	image := lambda.Name
-	container := "doless-" + lambda.Name
-	lambda.Docker.Image = &image
-	lambda.Docker.Container = &container
+	if lambda == nil {
+		return nil, errors.New("not found")
+	}
 
-	containerID, err := s.dockerSvc.Create(ctx, lambda, tar)
+	version, err := s.addVersion(ctx, lambda, cVersion)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	if err := s.updateLambda(ctx, *lambda); err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
+
+// addVersion stores sources of the next lambda version and adds it to the lambda, which is left to the caller to save.
+// Sources of unsaved versions are overwritten by the next ones.
+func (s *service) addVersion(ctx context.Context, lambda *api.Lambda, cVersion *api.CreateLambdaVersion) (*api.LambdaVersion, error) {
+	version := api.LambdaVersion{
+		Version:   lambda.Version + 1,
+		CreatedAt: time.Now().UnixMilli(),
+	}
+
+	if err := s.bootstrapSources(ctx, lambda.Id, version.Version, cVersion.Archive, cVersion.Manifest); err != nil {
+		return nil, err
 	}
 
-	lambda.Docker.ContainerId = &containerID
+	lambda.Version = version.Version
+	lambda.Versions = append(lambda.Versions, version)
+	lambda.UpdatedAt = version.CreatedAt
 
-	return containerID, s.dockerSvc.Start(ctx, lambda)
+	return &version, nil
 }
 
-func (s service) Start(ctx context.Context, id string) error {
+func (s *service) SetAlias(ctx context.Context, id string, alias string, version int64) (*api.Lambda, error) {
 	if succ := s.starting.AddUniq(id); !succ {
-		return fmt.Errorf("lambda '%s' is already being processed", id)
+		return nil, fmt.Errorf("lambda '%s' is already being processed", id)
 	}
 	defer s.starting.Remove(id)
 
 	lambda, err := GetLambda(ctx, id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if lambda == nil {
-		return errors.New("not found")
+		return nil, errors.New("not found")
 	}
 
-	if _, err = s.start(ctx, lambda); err != nil {
-		return err
+	if _, exists := lo.Find(lambda.Versions, func(v api.LambdaVersion) bool { return v.Version == version }); !exists {
+		return nil, fmt.Errorf("version is not found: %d", version)
+	}
+
+	servedVersion := ServedVersion(lambda)
+
+	if alias == LiveAlias && deployed(lambda) && version != servedVersion {
+		// Live alias is the deploy version, moving it doesn't roll the lambda containers out
+		return nil, fmt.Errorf("alias %s can't point to version %d while version %d is deployed, update the lambda code to roll it out", alias, version, servedVersion)
+	}
+
+	aliases := lo.Reject(lambda.Aliases, func(a api.LambdaAlias, _ int) bool { return a.Name == alias })
+	lambda.Aliases = append(aliases, api.LambdaAlias{Name: alias, Version: version})
+
+	if version != servedVersion {
+		// Lambda containers run the served version only, so the endpoint would have nothing serving it
+		endpoint, err := db.FindValue(ctx, "endpoint", func(val *api.Endpoint) bool {
+			return val.Lambda == id && val.GetAlias() == alias
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if endpoint != nil {
+			return nil, fmt.Errorf("alias is used by endpoint %s, it can't point to version %d while version %d is deployed", endpoint.Id, version, servedVersion)
+		}
+	}
+
+	return lambda, s.commitAliases(ctx, lambda)
+}
+
+func (s *service) DeleteAlias(ctx context.Context, id string, alias string) (*api.Lambda, error) {
+	if succ := s.starting.AddUniq(id); !succ {
+		return nil, fmt.Errorf("lambda '%s' is already being processed", id)
+	}
+	defer s.starting.Remove(id)
+
+	lambda, err := GetLambda(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if lambda == nil {
+		return nil, errors.New("not found")
+	}
+
+	if _, exists := lo.Find(lambda.Aliases, func(a api.LambdaAlias) bool { return a.Name == alias }); !exists {
+		return nil, fmt.Errorf("alias is not found: %s", alias)
+	}
+
+	endpoint, err := db.FindValue(ctx, "endpoint", func(val *api.Endpoint) bool {
+		return val.Lambda == id && val.GetAlias() == alias
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if endpoint != nil {
+		return nil, fmt.Errorf("alias is used by endpoint: %s", endpoint.Id)
 	}
 
+	lambda.Aliases = lo.Reject(lambda.Aliases, func(a api.LambdaAlias, _ int) bool { return a.Name == alias })
+
+	return lambda, s.commitAliases(ctx, lambda)
+}
+
+// commitAliases stores alias changes and exposes them on the network of the lambda containers.
+func (s *service) commitAliases(ctx context.Context, lambda *api.Lambda) error {
+	lambda.UpdatedAt = time.Now().UnixMilli()
+
 	if err := s.updateLambda(ctx, *lambda); err != nil {
 		return err
 	}
 
-	lctx, cancel := context.WithCancel(context.Background())
-	s.inspect.Set(lambda.Id, cancel)
-	go s.inspectRoutine(lctx, *lambda)
-
-	return nil
+	return s.reconnectReplicas(ctx, lambda)
 }
 
-func (s service) Destroy(ctx context.Context, id string) error {
+// SetEnv replaces lambda environment. Secret values are encrypted and stored apart from the lambda,
+// which keeps only masked values. Existing container is recreated from the same image.
+func (s *service) SetEnv(ctx context.Context, id string, env []api.LambdaEnvVar) error {
 	if succ := s.starting.AddUniq(id); !succ {
 		return fmt.Errorf("lambda '%s' is already being processed", id)
 	}
@@ -266,80 +506,789 @@ func (s service) Destroy(ctx context.Context, id string) error {
 		return errors.New("not found")
 	}
 
-	s.inspect.Get(id, func() {})()
-	s.inspect.Delete(id)
+	prevSecrets, err := GetSecrets(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	lambdaEnv, secrets, err := secretKey.Merge(env, prevSecrets)
+	if err != nil {
+		return err
+	}
 
-	if err := s.dockerSvc.Remove(ctx, lambda); err != nil {
+	if err := SetSecrets(ctx, id, secrets); err != nil {
 		return err
 	}
 
-	lambda.Docker = api.Docker{}
+	lambda.Env = lambdaEnv
+	lambda.UpdatedAt = time.Now().UnixMilli()
 
 	if err := s.updateLambda(ctx, *lambda); err != nil {
 		return err
 	}
 
-	return nil
+	if !deployed(lambda) {
+		return nil
+	}
+
+	return s.recreate(ctx, lambda)
 }
 
-func (s service) updateLambda(ctx context.Context, lambda api.Lambda) error {
-	var updateErr error
-	s.lambdas.Update(lambda.Id, func(prev api.Lambda) api.Lambda {
-		if err := SetLambda(ctx, &lambda); err != nil {
-			updateErr = err
-			return prev
-		}
+// containerOptions resolves container settings of the lambda including decrypted secrets and runtime healthcheck.
+func (s service) containerOptions(ctx context.Context, lambda *api.Lambda) (docker.ContainerOptions, error) {
+	opts := docker.ContainerOptions{
+		Env:       []string{},
+		Resources: model.WithDefaultResources(lambda.Resources),
+		Lambda:    lambda.Id,
+		Restart:   model.WithDefaultRestartPolicy(lambda.RestartPolicy),
+	}
 
-		return lambda
-	})
+	runtime, err := GetRuntime(ctx, lambda.Runtime)
+	if err != nil {
+		return opts, err
+	}
 
-	return updateErr
-}
+	if runtime != nil {
+		opts.Healthcheck = runtime.Healthcheck
+	}
 
-func (s service) inspectRoutine(ctx context.Context, lambda api.Lambda) {
-	id := *lambda.Docker.ContainerId
-	for {
-		container, err := s.dockerSvc.Inspect(ctx, id)
-		actual, rErr := GetLambda(ctx, lambda.Id)
-		if rErr != nil && actual == nil {
-			rErr = errors.New("not found")
-		}
+	if len(lambda.Env) == 0 {
+		return opts, nil
+	}
 
-		if rErr == nil {
-			if err != nil {
-				// TODO: Handle external delete case and stop
-				logger.L.Error(
-					"Failed to inspect container",
-					zap.Error(err),
-					zap.String("container_id", id),
-				)
-				lambda.Docker.Status = "error"
-			} else {
-				lambda.Docker.Status = container.State.Health.Status
+	secrets, err := GetSecrets(ctx, lambda.Id)
+	if err != nil {
+		return opts, err
+	}
+
+	for _, v := range lambda.Env {
+		value := v.Value
+
+		if v.GetSecret() {
+			encrypted, exists := secrets[v.Name]
+			if !exists {
+				return opts, fmt.Errorf("secret is not found: %s", v.Name)
 			}
 
-			if actual.Docker.Status != lambda.Docker.Status {
-				if err := s.updateLambda(ctx, lambda); err != nil {
-					logger.L.Error(
-						"Failed to update lambda",
-						zap.Error(err),
-						zap.String("id", lambda.Id),
-					)
-				}
+			if value, err = secretKey.Decrypt(encrypted); err != nil {
+				return opts, err
 			}
-		} else {
+		}
+
+		opts.Env = append(opts.Env, v.Name+"="+value)
+	}
+
+	return opts, nil
+}
+
+// recreate replaces lambda containers with new ones created from the same image,
+// so container settings are applied without rebuild. Stopped lambda stays stopped.
+func (s service) recreate(ctx context.Context, lambda *api.Lambda) error {
+	opts, err := s.containerOptions(ctx, lambda)
+	if err != nil {
+		return err
+	}
+
+	if err := s.removeReplicas(ctx, lambda.Docker.Replicas); err != nil {
+		return err
+	}
+
+	replicas, err := s.createReplicas(ctx, lambda, opts, 0, len(lambda.Docker.Replicas), docker.NetworkAliases(lambda))
+	if err != nil {
+		rctx, cancel := rollbackContext()
+		defer cancel()
+
+		// Lambda is left without containers, so the next start builds it from scratch
+		if rErr := s.dockerSvc.RemoveImage(rctx, *lambda.Docker.Image); rErr != nil {
 			logger.L.Error(
-				"Failed to gather lambda",
+				"Failed to remove image",
 				zap.Error(rErr),
+				zap.String("id", *lambda.Docker.Image),
+			)
+		}
+
+		lambda.Docker = api.Docker{}
+		transition(lambda, model.PendingState, "failed to recreate containers: "+err.Error())
+		if uErr := s.updateLambda(rctx, *lambda); uErr != nil {
+			logger.L.Error(
+				"Failed to update lambda",
+				zap.Error(uErr),
 				zap.String("id", lambda.Id),
 			)
 		}
 
-		select {
-		case <-time.After(10 * time.Second):
-			continue
-		case <-ctx.Done():
-			return
+		return err
+	}
+
+	lambda.Docker.Replicas = replicas
+
+	if !stopped(lambda) {
+		if err := transition(lambda, model.StartingState, "containers are recreated"); err != nil {
+			return err
+		}
+
+		if err := s.startReplicas(ctx, replicas); err != nil {
+			return err
 		}
+
+		lambda.Docker.Status = ""
+	}
+
+	if err := s.updateLambda(ctx, *lambda); err != nil {
+		return err
+	}
+
+	if !stopped(lambda) {
+		s.resyncLambda(ctx, lambda.Id)
+	}
+
+	return nil
+}
+
+// buildArgs resolves values of the runtime parameters for the lambda. Runtime parameters may change
+// after the lambda is created, so its build args are validated again.
+func buildArgs(lambda *api.Lambda, runtime *api.Runtime) (map[string]*string, error) {
+	values, err := model.ResolveBuildArgs(lambda.BuildArgs, runtime.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("invalid build args: %w", err)
+	}
+
+	args, err := baseBuildArgs(runtime)
+	if err != nil {
+		return nil, err
+	}
+
+	if args == nil {
+		args = map[string]*string{}
+	}
+
+	for name, value := range values {
+		value := value
+		args[name] = &value
+	}
+
+	return args, nil
+}
+
+// DeployVersion resolves the version which should be run by the lambda container.
+func DeployVersion(lambda *api.Lambda) int64 {
+	if alias, exists := lo.Find(lambda.Aliases, func(a api.LambdaAlias) bool { return a.Name == LiveAlias }); exists {
+		return alias.Version
+	}
+
+	return lambda.Version
+}
+
+// ServedVersion is the version which handler routes to: the one of the lambda containers.
+// Undeployed lambdas get the deploy version, as they are built from it once started.
+func ServedVersion(lambda *api.Lambda) int64 {
+	if deployed(lambda) && lambda.Docker.Version != nil {
+		return *lambda.Docker.Version
 	}
+
+	return DeployVersion(lambda)
+}
+
+// lambdaBuild is the build of the lambda version with the current revision of its runtime.
+type lambdaBuild struct {
+	tar  io.Reader
+	args map[string]*string
+	// image is tagged by both lambda version and runtime revision, so rebuilt lambda runs alongside the old image
+	image           string
+	runtimeRevision int64
+}
+
+// tarLambda packs the lambda version together with the current revision of its runtime.
+// Build args pass the runtime parameters and point the runtime Dockerfile to the runtime base image if there is one.
+func tarLambda(ctx context.Context, lambda *api.Lambda, version int64) (*lambdaBuild, error) {
+	runtime, err := GetRuntime(ctx, lambda.Runtime)
+	if err != nil {
+		return nil, err
+	}
+
+	if runtime == nil {
+		return nil, fmt.Errorf("runtime is not found: %s", lambda.Runtime)
+	}
+
+	args, err := buildArgs(lambda, runtime)
+	if err != nil {
+		return nil, err
+	}
+
+	tar, err := TarLambda(ctx, lambda.Id, version, runtime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lambdaBuild{
+		tar:             tar,
+		args:            args,
+		image:           fmt.Sprintf("%s:%d-r%d", lambda.Name, version, runtime.Revision),
+		runtimeRevision: runtime.Revision,
+	}, nil
+}
+
+func (s service) start(ctx context.Context, lambda *api.Lambda, progress docker.BuildProgress) error {
+	version := DeployVersion(lambda)
+
+	build, err := tarLambda(ctx, lambda, version)
+	if err != nil {
+		return err
+	}
+
+	image := build.image
+	lambda.Docker.Image = &image
+	lambda.Docker.Version = &version
+	lambda.Docker.RuntimeRevision = &build.runtimeRevision
+
+	opts, err := s.containerOptions(ctx, lambda)
+	if err != nil {
+		return err
+	}
+
+	if err := s.dockerSvc.Build(ctx, image, build.tar, build.args, progress); err != nil {
+		lambda.Docker = api.Docker{}
+		transition(lambda, model.BuildFailedState, "build failed: "+err.Error())
+		return err
+	}
+
+	replicas, err := s.createReplicas(ctx, lambda, opts, 0, replicaCount(lambda), docker.NetworkAliases(lambda))
+	if err == nil {
+		if err = s.startReplicas(ctx, replicas); err != nil {
+			rctx, cancel := rollbackContext()
+			defer cancel()
+
+			s.removeReplicas(rctx, replicas)
+		}
+	}
+
+	if err != nil {
+		rctx, cancel := rollbackContext()
+		defer cancel()
+
+		if rErr := s.dockerSvc.RemoveImage(rctx, image); rErr != nil {
+			logger.L.Error(
+				"Failed to remove image",
+				zap.Error(rErr),
+				zap.String("id", image),
+			)
+		}
+
+		lambda.Docker = api.Docker{}
+
+		return err
+	}
+
+	lambda.Docker.Replicas = replicas
+
+	return nil
+}
+
+func (s service) Start(ctx context.Context, id string, progress docker.BuildProgress) error {
+	if succ := s.starting.AddUniq(id); !succ {
+		return fmt.Errorf("lambda '%s' is already being processed", id)
+	}
+	defer s.starting.Remove(id)
+
+	lambda, err := GetLambda(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if lambda == nil {
+		return errors.New("not found")
+	}
+
+	if err := s.launch(ctx, lambda, progress); err != nil {
+		return err
+	}
+
+	if err := s.updateLambda(ctx, *lambda); err != nil {
+		return err
+	}
+
+	s.resyncLambda(ctx, lambda.Id)
+
+	return nil
+}
+
+// launch starts lambda containers reusing the stopped ones if they run the deploy version.
+func (s service) launch(ctx context.Context, lambda *api.Lambda, progress docker.BuildProgress) error {
+	if deployed(lambda) && lambda.Docker.GetVersion() == DeployVersion(lambda) {
+		// Containers of the deploy version are kept, so there is nothing to build
+		if err := transition(lambda, model.StartingState, "lambda is started"); err != nil {
+			return err
+		}
+
+		if err := s.startReplicas(ctx, lambda.Docker.Replicas); err != nil {
+			return err
+		}
+	} else {
+		if err := transition(lambda, model.BuildingState, fmt.Sprintf("version %d is being built", DeployVersion(lambda))); err != nil {
+			return err
+		}
+
+		if deployed(lambda) {
+			// Stopped containers run outdated version
+			if err := s.teardown(ctx, lambda); err != nil {
+				return err
+			}
+
+			lambda.Docker = api.Docker{}
+		}
+
+		if err := s.updateLambda(ctx, *lambda); err != nil {
+			return err
+		}
+
+		if err := s.start(ctx, lambda, progress); err != nil {
+			rctx, cancel := rollbackContext()
+			defer cancel()
+
+			// Build failure is recorded by start, other failures leave the lambda undeployed
+			lambda.Docker = api.Docker{}
+			if lambdaState(lambda) == model.BuildingState {
+				transition(lambda, model.PendingState, "failed to start: "+err.Error())
+			}
+
+			if uErr := s.updateLambda(rctx, *lambda); uErr != nil {
+				logger.L.Error(
+					"Failed to update lambda",
+					zap.Error(uErr),
+					zap.String("id", lambda.Id),
+				)
+			}
+
+			return err
+		}
+
+		if err := transition(lambda, model.StartingState, "lambda is started"); err != nil {
+			return err
+		}
+	}
+
+	lambda.Docker.Status = ""
+
+	// Idle timeout is counted from the start
+	return SetActivity(ctx, lambda.Id, time.Now().UnixMilli())
+}
+
+// StopLambda stops lambda containers, but keeps both containers and image for the next start.
+func (s service) StopLambda(ctx context.Context, id string) error {
+	if succ := s.starting.AddUniq(id); !succ {
+		return fmt.Errorf("lambda '%s' is already being processed", id)
+	}
+	defer s.starting.Remove(id)
+
+	lambda, err := GetLambda(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if lambda == nil {
+		return errors.New("not found")
+	}
+
+	if !deployed(lambda) {
+		return errors.New("lambda is not started")
+	}
+
+	if err := transition(lambda, model.StoppedState, "lambda is stopped"); err != nil {
+		return err
+	}
+
+	if err := s.stopReplicas(ctx, lambda.Docker.Replicas); err != nil {
+		return err
+	}
+
+	setStatus(lambda, ExitedStatus)
+
+	return s.updateLambda(ctx, *lambda)
+}
+
+// Scale changes number of lambda containers. New containers of running lambda
+// receive traffic once they are healthy, removed ones stop receiving it before removal.
+func (s service) Scale(ctx context.Context, id string, replicas int64) error {
+	if succ := s.starting.AddUniq(id); !succ {
+		return fmt.Errorf("lambda '%s' is already being processed", id)
+	}
+	defer s.starting.Remove(id)
+
+	lambda, err := GetLambda(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if lambda == nil {
+		return errors.New("not found")
+	}
+
+	lambda.Replicas = &replicas
+	lambda.UpdatedAt = time.Now().UnixMilli()
+
+	current := len(lambda.Docker.Replicas)
+	target := replicaCount(lambda)
+
+	if !deployed(lambda) || current == target {
+		return s.updateLambda(ctx, *lambda)
+	}
+
+	if target < current {
+		removed := lambda.Docker.Replicas[target:]
+		lambda.Docker.Replicas = lambda.Docker.Replicas[:target]
+		lambda.Docker.Status = aggregateStatus(lambda.Docker.Replicas)
+		followReplicas(lambda)
+
+		if err := s.updateLambda(ctx, *lambda); err != nil {
+			return err
+		}
+
+		return s.removeReplicas(ctx, removed)
+	}
+
+	opts, err := s.containerOptions(ctx, lambda)
+	if err != nil {
+		return err
+	}
+
+	if stopped(lambda) {
+		added, err := s.createReplicas(ctx, lambda, opts, current, target, docker.NetworkAliases(lambda))
+		if err != nil {
+			return err
+		}
+
+		for i := range added {
+			added[i].Status = lambda.Docker.Status
+		}
+
+		lambda.Docker.Replicas = append(lambda.Docker.Replicas, added...)
+
+		return s.updateLambda(ctx, *lambda)
+	}
+
+	added, err := s.createReplicas(ctx, lambda, opts, current, target, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := s.promoteReplicas(ctx, lambda, added); err != nil {
+		rctx, cancel := rollbackContext()
+		defer cancel()
+
+		s.removeReplicas(rctx, added)
+		return err
+	}
+
+	lambda.Docker.Replicas = append(lambda.Docker.Replicas, added...)
+
+	if err := s.updateLambda(ctx, *lambda); err != nil {
+		return err
+	}
+
+	s.resyncLambda(ctx, lambda.Id)
+
+	return nil
+}
+
+// UpdateCode creates new lambda version from the archive or the manifest and makes it live.
+// Running lambda is rolled out without downtime: new containers are started alongside the old ones
+// and receive traffic only after their healthchecks turn healthy. The version is saved once it is rolled out.
+func (s service) UpdateCode(ctx context.Context, id string, req *api.UpdateLambdaCode, progress docker.BuildProgress) error {
+	if succ := s.starting.AddUniq(id); !succ {
+		return fmt.Errorf("lambda '%s' is already being processed", id)
+	}
+	defer s.starting.Remove(id)
+
+	lambda, err := GetLambda(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if lambda == nil {
+		return errors.New("not found")
+	}
+
+	version, err := s.addVersion(ctx, lambda, &api.CreateLambdaVersion{Archive: req.Archive, Manifest: req.Manifest})
+	if err != nil {
+		return err
+	}
+
+	if _, exists := lo.Find(lambda.Aliases, func(a api.LambdaAlias) bool { return a.Name == LiveAlias }); exists {
+		aliases := lo.Reject(lambda.Aliases, func(a api.LambdaAlias, _ int) bool { return a.Name == LiveAlias })
+		lambda.Aliases = append(aliases, api.LambdaAlias{Name: LiveAlias, Version: version.Version})
+	}
+
+	if !deployed(lambda) || stopped(lambda) {
+		// Nothing is running, new version will be deployed on the next start
+		return s.updateLambda(ctx, *lambda)
+	}
+
+	return s.replace(ctx, lambda, progress)
+}
+
+// replace starts deploy version of the lambda in new containers and removes the old ones.
+// Old containers keep running meanwhile, so the lambda follows them again if the rollout fails.
+func (s service) replace(ctx context.Context, prev *api.Lambda, progress docker.BuildProgress) error {
+	version := DeployVersion(prev)
+
+	// Only the state is saved, so the rest of the lambda, including its new version, is saved once the rollout succeeds
+	if err := s.setState(ctx, prev.Id, model.BuildingState, fmt.Sprintf("version %d is being rolled out", version)); err != nil {
+		return err
+	}
+
+	err := s.rollout(ctx, prev, version, progress)
+	if err == nil {
+		return nil
+	}
+
+	rctx, cancel := rollbackContext()
+	defer cancel()
+
+	// Old containers are kept running, so the lambda follows their statuses again once resynced
+	if sErr := s.setState(rctx, prev.Id, model.StartingState, fmt.Sprintf("rollout of version %d failed: %s", version, err)); sErr != nil {
+		logger.L.Error(
+			"Failed to update lambda",
+			zap.Error(sErr),
+			zap.String("id", prev.Id),
+		)
+	}
+
+	s.resyncLambda(rctx, prev.Id)
+
+	return err
+}
+
+// rollout starts the version of the lambda in new containers, makes them live and removes the old ones once they are drained.
+func (s service) rollout(ctx context.Context, prev *api.Lambda, version int64, progress docker.BuildProgress) error {
+	next := *prev
+	next.Docker = api.Docker{}
+
+	build, err := tarLambda(ctx, &next, version)
+	if err != nil {
+		return err
+	}
+
+	image := build.image
+	next.Docker.Image = &image
+	next.Docker.Version = &version
+	next.Docker.RuntimeRevision = &build.runtimeRevision
+
+	opts, err := s.containerOptions(ctx, &next)
+	if err != nil {
+		return err
+	}
+
+	if err := s.dockerSvc.Build(ctx, image, build.tar, build.args, progress); err != nil {
+		return err
+	}
+
+	replicas, err := s.createReplicas(ctx, &next, opts, 0, replicaCount(&next), nil)
+	if err == nil {
+		if err = s.promoteReplicas(ctx, &next, replicas); err != nil {
+			rctx, cancel := rollbackContext()
+			defer cancel()
+
+			s.removeReplicas(rctx, replicas)
+		}
+	}
+
+	if err != nil {
+		rctx, cancel := rollbackContext()
+		defer cancel()
+
+		if rErr := s.dockerSvc.RemoveImage(rctx, image); rErr != nil {
+			logger.L.Error(
+				"Failed to remove image",
+				zap.Error(rErr),
+				zap.String("id", image),
+			)
+		}
+
+		return err
+	}
+
+	next.Docker.Replicas = replicas
+	next.UpdatedAt = time.Now().UnixMilli()
+
+	err = transition(&next, model.StartingState, fmt.Sprintf("version %d is rolled out", version))
+	if err == nil {
+		err = s.updateLambda(ctx, next)
+	}
+
+	if err != nil {
+		// Handler still balances over the old replicas, so they are kept and the new ones are removed
+		rctx, cancel := rollbackContext()
+		defer cancel()
+
+		s.teardown(rctx, &next)
+		return err
+	}
+
+	s.resyncLambda(ctx, next.Id)
+
+	// Handler switches to the new replicas once it is notified of the saved lambda,
+	// requests sent to the old ones in the meantime are served before they are removed
+	select {
+	case <-time.After(drainPeriod):
+	case <-ctx.Done():
+	}
+
+	rctx, cancel := rollbackContext()
+	defer cancel()
+
+	if err := s.teardown(rctx, prev); err != nil {
+		logger.L.Error(
+			"Failed to remove replaced containers",
+			zap.Error(err),
+			zap.String("lambda", prev.Id),
+		)
+	}
+
+	return nil
+}
+
+func (s service) Destroy(ctx context.Context, id string) error {
+	if succ := s.starting.AddUniq(id); !succ {
+		return fmt.Errorf("lambda '%s' is already being processed", id)
+	}
+	defer s.starting.Remove(id)
+
+	lambda, err := GetLambda(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if lambda == nil {
+		return errors.New("not found")
+	}
+
+	if err := s.destroying(ctx, lambda); err != nil {
+		return err
+	}
+
+	lambda.Docker = api.Docker{}
+
+	if err := transition(lambda, model.PendingState, "lambda is destroyed"); err != nil {
+		return err
+	}
+
+	if err := s.updateLambda(ctx, *lambda); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// destroying tears the lambda down in destroying state. If it fails, the lambda gets the state of what is left.
+func (s service) destroying(ctx context.Context, lambda *api.Lambda) error {
+	if err := transition(lambda, model.DestroyingState, "lambda is being destroyed"); err != nil {
+		return err
+	}
+
+	if err := s.updateLambda(ctx, *lambda); err != nil {
+		return err
+	}
+
+	err := s.teardown(ctx, lambda)
+	if err == nil {
+		return nil
+	}
+
+	rctx, cancel := rollbackContext()
+	defer cancel()
+
+	transition(lambda, settledState(lambda), "failed to destroy: "+err.Error())
+	if uErr := s.updateLambda(rctx, *lambda); uErr != nil {
+		logger.L.Error(
+			"Failed to update lambda",
+			zap.Error(uErr),
+			zap.String("id", lambda.Id),
+		)
+	}
+
+	return err
+}
+
+// Delete removes lambda completely: container, image, code of all versions and metadata.
+// Endpoints of the lambda are deleted too when cascade is set, otherwise deletion is refused.
+func (s service) Delete(ctx context.Context, id string, cascade bool) error {
+	if succ := s.starting.AddUniq(id); !succ {
+		return fmt.Errorf("lambda '%s' is already being processed", id)
+	}
+	defer s.starting.Remove(id)
+
+	lambda, err := GetLambda(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if lambda == nil {
+		return errors.New("not found")
+	}
+
+	endpoints, err := db.GetValues[api.Endpoint](ctx, "endpoint")
+	if err != nil {
+		return err
+	}
+
+	endpoints = lo.Filter(endpoints, func(e *api.Endpoint, _ int) bool { return e.Lambda == id })
+
+	if len(endpoints) > 0 && !cascade {
+		ids := lo.Map(endpoints, func(e *api.Endpoint, _ int) string { return e.Id })
+		return fmt.Errorf("lambda is used by endpoints: %s", strings.Join(ids, ", "))
+	}
+
+	if err := s.destroying(ctx, lambda); err != nil {
+		return err
+	}
+
+	if err := RemoveLambda(ctx, id); err != nil {
+		return err
+	}
+
+	if err := DeleteSecrets(ctx, id); err != nil {
+		return err
+	}
+
+	if err := DeleteActivity(ctx, id); err != nil {
+		return err
+	}
+
+	if err := DeleteMetrics(ctx, id); err != nil {
+		return err
+	}
+
+	if err := DeleteEvents(ctx, id); err != nil {
+		return err
+	}
+
+	// Endpoints go last, so they are kept along with the lambda if anything above fails
+	for _, endpoint := range endpoints {
+		if err := db.DelValue(ctx, "endpoint:"+endpoint.Id); err != nil {
+			return err
+		}
+	}
+
+	if err := DeleteLambda(ctx, id); err != nil {
+		return err
+	}
+
+	s.lambdas.Delete(id)
+	s.scaledAt.Delete(id)
+
+	return nil
+}
+
+func (s service) updateLambda(ctx context.Context, lambda api.Lambda) error {
+	var updateErr error
+	s.lambdas.Update(lambda.Id, func(prev api.Lambda) api.Lambda {
+		if err := SetLambda(ctx, &lambda); err != nil {
+			updateErr = err
+			return prev
+		}
+
+		return lambda
+	})
+
+	return updateErr
 }
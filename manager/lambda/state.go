@@ -0,0 +1,173 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/hedlx/doless/client"
+	"github.com/hedlx/doless/manager/model"
+	"github.com/samber/lo"
+)
+
+// Docker statuses which marked stopped lambdas before states were introduced.
+const (
+	legacyStoppedStatus = "stopped"
+	legacyIdleStatus    = "idle"
+)
+
+// lambdaState returns lifecycle state of the lambda. Lambdas saved before states were introduced get it from docker status.
+func lambdaState(lambda *api.Lambda) string {
+	if lambda.State != nil {
+		return *lambda.State
+	}
+
+	switch {
+	case !deployed(lambda):
+		return model.PendingState
+	case lambda.Docker.Status == legacyStoppedStatus:
+		return model.StoppedState
+	case lambda.Docker.Status == legacyIdleStatus:
+		return model.IdleState
+	}
+
+	return replicasState(lambda.Docker.Replicas)
+}
+
+// migrateState saves the state of the lambda saved before states were introduced.
+// Stopped containers get docker status instead of the legacy ones.
+func migrateState(lambda *api.Lambda) bool {
+	if lambda.State != nil {
+		return false
+	}
+
+	state := lambdaState(lambda)
+	transition(lambda, state, "state is restored from docker status")
+
+	if lo.Contains(model.StoppedStates, state) {
+		setStatus(lambda, ExitedStatus)
+	}
+
+	return true
+}
+
+// settledState is the state of the lambda left by an interrupted or failed operation, it is decided by what is deployed.
+// Lambda whose containers are all exited is considered stopped, running one is considered starting until replica statuses tell otherwise.
+func settledState(lambda *api.Lambda) string {
+	if !deployed(lambda) {
+		return model.PendingState
+	}
+
+	// Stopped lambdas are only destroyed, while rollouts are done to running ones
+	exited := lo.CountBy(lambda.Docker.Replicas, func(replica api.Replica) bool { return replica.Status == ExitedStatus })
+	if lambdaState(lambda) == model.DestroyingState && exited > 0 && exited == len(lambda.Docker.Replicas) {
+		return model.StoppedState
+	}
+
+	return model.StartingState
+}
+
+// stopped reports whether lambda containers are kept stopped.
+func stopped(lambda *api.Lambda) bool {
+	return lo.Contains(model.StoppedStates, lambdaState(lambda))
+}
+
+// transition changes lifecycle state of the lambda, illegal transitions are refused.
+// Reason is updated even if the state is the same.
+func transition(lambda *api.Lambda, state string, reason string) error {
+	current := lambdaState(lambda)
+
+	if err := model.ValidateTransition(current, state); err != nil {
+		return err
+	}
+
+	if current != state || lambda.State == nil {
+		changedAt := time.Now().UnixMilli()
+		lambda.StateChangedAt = &changedAt
+	}
+
+	lambda.State = &state
+	lambda.StatusReason = &reason
+
+	return nil
+}
+
+// replicasState derives state of the running lambda from statuses of its replicas.
+func replicasState(replicas []api.Replica) string {
+	crashed, unhealthy, starting := 0, 0, 0
+
+	for _, replica := range replicas {
+		switch replica.Status {
+		// Reconnecting replica keeps running, it's only drained
+		case "healthy", "running", ReconnectingStatus:
+		case "starting", "created", "restarting", "":
+			starting++
+		case "unhealthy":
+			unhealthy++
+		default:
+			crashed++
+		}
+	}
+
+	switch {
+	case len(replicas) > 0 && crashed == len(replicas):
+		return model.CrashedState
+	case crashed > 0 || unhealthy > 0:
+		return model.UnhealthyState
+	case starting > 0:
+		return model.StartingState
+	default:
+		return model.HealthyState
+	}
+}
+
+// replicasReason describes replicas which keep the lambda from being healthy.
+func replicasReason(replicas []api.Replica) string {
+	for _, replica := range replicas {
+		switch replica.Status {
+		case "healthy", "running", ReconnectingStatus:
+			continue
+		case CrashLoopStatus:
+			return fmt.Sprintf("container %s keeps failing with exit code %d", replica.Container, replica.GetExitCode())
+		}
+
+		if replica.ExitCode != nil && replica.GetOomKilled() {
+			return fmt.Sprintf("container %s is %s, it ran out of memory", replica.Container, replica.Status)
+		}
+
+		return fmt.Sprintf("container %s is %s", replica.Container, replica.Status)
+	}
+
+	return "all containers are healthy"
+}
+
+// followReplicas updates state of the running lambda according to statuses of its replicas.
+func followReplicas(lambda *api.Lambda) {
+	if !lo.Contains(model.RunningStates, lambdaState(lambda)) {
+		return
+	}
+
+	// Running states may be changed to each other, so the transition never fails
+	transition(lambda, replicasState(lambda.Docker.Replicas), replicasReason(lambda.Docker.Replicas))
+}
+
+// setState changes state of the lambda leaving the rest of its record as is.
+func (s service) setState(ctx context.Context, id string, state string, reason string) error {
+	var updateErr error
+	s.lambdas.Update(id, func(prev api.Lambda) api.Lambda {
+		lambda := prev
+		if err := transition(&lambda, state, reason); err != nil {
+			updateErr = err
+			return prev
+		}
+
+		if err := SetLambda(ctx, &lambda); err != nil {
+			updateErr = err
+			return prev
+		}
+
+		return lambda
+	})
+
+	return updateErr
+}
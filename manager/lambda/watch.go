@@ -0,0 +1,293 @@
+package lambda
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"time"
+
+	api "github.com/hedlx/doless/client"
+	"github.com/hedlx/doless/manager/docker"
+	"github.com/hedlx/doless/manager/logger"
+	"github.com/hedlx/doless/manager/util"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+// RemovedStatus marks replicas whose container is deleted externally until the reconciler recreates it.
+const RemovedStatus = "removed"
+
+// OOMKilledEvent is reported when lambda container runs out of memory.
+const OOMKilledEvent = "OOMKilled"
+
+// resyncInterval is how often replica statuses are inspected in case some docker events are missed.
+var resyncInterval = time.Duration(util.GetIntVarDefault("STATUS_RESYNC_INTERVAL", 5*60)) * time.Second
+
+// controlInterval is how often running lambdas are checked for idle timeout and autoscaled.
+const controlInterval = 10 * time.Second
+
+// inspectedActions are container event actions which change container state, but don't tell the resulting status.
+var inspectedActions = []string{"start", "restart", "die", "stop", "pause", "unpause"}
+
+// eventsRoutine keeps statuses of the lambda replicas up to date following docker container events.
+func (s service) eventsRoutine(ctx context.Context) {
+	for {
+		err := s.dockerSvc.Events(ctx, func(e docker.ContainerEvent) {
+			s.handleContainerEvent(ctx, e)
+		})
+		if ctx.Err() != nil {
+			return
+		}
+
+		logger.L.Error("Docker events subscription is interrupted", zap.Error(err))
+
+		select {
+		case <-time.After(5 * time.Second):
+		case <-ctx.Done():
+			return
+		}
+
+		// Events are missed while resubscribing
+		s.resync(ctx)
+	}
+}
+
+func (s service) handleContainerEvent(ctx context.Context, e docker.ContainerEvent) {
+	e.Lambda = s.eventLambda(e)
+	if e.Lambda == "" {
+		return
+	}
+
+	if e.Action == "oom" {
+		if lambda := s.lambdas.Get(e.Lambda, api.Lambda{}); lambda.Id != "" {
+			reportEvent(ctx, e.Lambda, OOMKilledEvent, "container "+e.Container+" ran out of memory")
+		}
+
+		// Die event follows
+		return
+	}
+
+	update := eventUpdate(e.Action, func() replicaState {
+		return s.inspectReplica(ctx, e.Container)
+	})
+	if update == nil {
+		return
+	}
+
+	if err := s.updateReplica(ctx, e.Lambda, e.Container, update); err != nil {
+		logger.L.Error(
+			"Failed to update lambda",
+			zap.Error(err),
+			zap.String("id", e.Lambda),
+		)
+	}
+}
+
+// eventUpdate returns update of the replica the container event action implies, nil if the action doesn't change
+// replica status. Inspect is called only for the actions which don't tell the resulting status.
+func eventUpdate(action string, inspect func() replicaState) func(lambda *api.Lambda, replica *api.Replica) {
+	switch {
+	case strings.HasPrefix(action, "health_status: "):
+		return func(_ *api.Lambda, replica *api.Replica) {
+			replica.Status = strings.TrimPrefix(action, "health_status: ")
+		}
+	case action == "destroy":
+		return func(_ *api.Lambda, replica *api.Replica) {
+			replica.Status = RemovedStatus
+		}
+	case lo.Contains(inspectedActions, action):
+		state := inspect()
+		return func(lambda *api.Lambda, replica *api.Replica) {
+			applyState(lambda, replica, state)
+		}
+	default:
+		return nil
+	}
+}
+
+// eventLambda returns id of the lambda the event container belongs to. Containers created before
+// the lambda label was introduced are looked up among the lambda replicas.
+func (s service) eventLambda(e docker.ContainerEvent) string {
+	if e.Lambda != "" {
+		return e.Lambda
+	}
+
+	lambda, _ := lo.Find(s.lambdas.Values(), func(lambda api.Lambda) bool {
+		_, found := lo.Find(lambda.Docker.Replicas, func(replica api.Replica) bool { return replica.ContainerId == e.Container })
+		return found
+	})
+
+	return lambda.Id
+}
+
+// applyState sets inspected container state to the replica. Exit details are kept while container is running,
+// so they tell about the last exit.
+func applyState(lambda *api.Lambda, replica *api.Replica, state replicaState) {
+	replica.Status = state.Status
+	replica.RestartCount = &state.RestartCount
+
+	if !state.Exited {
+		return
+	}
+
+	replica.ExitCode = &state.ExitCode
+	replica.OomKilled = &state.OOMKilled
+	lambda.Docker.ExitCode = &state.ExitCode
+	lambda.Docker.OomKilled = &state.OOMKilled
+
+	if crashLooping(lambda, state) {
+		replica.Status = CrashLoopStatus
+	}
+}
+
+// updateReplica updates the replica running in the container. The lambda is modified under the lock
+// of the lambdas map, so concurrent updates are never overwritten with a stale lambda. Lambdas stopped on purpose
+// are left as is.
+func (s service) updateReplica(ctx context.Context, id string, containerID string, update func(lambda *api.Lambda, replica *api.Replica)) error {
+	var updateErr error
+	// crashLooped is the container which has just exhausted its restarts
+	crashLooped := ""
+
+	s.lambdas.Update(id, func(prev api.Lambda) api.Lambda {
+		if stopped(&prev) {
+			return prev
+		}
+
+		i := -1
+		for j, replica := range prev.Docker.Replicas {
+			if replica.ContainerId == containerID {
+				i = j
+			}
+		}
+
+		if i < 0 {
+			return prev
+		}
+
+		lambda := prev
+		lambda.Docker.Replicas = append([]api.Replica{}, prev.Docker.Replicas...)
+		update(&lambda, &lambda.Docker.Replicas[i])
+
+		var restarts int64
+		for _, replica := range lambda.Docker.Replicas {
+			restarts += replica.GetRestartCount()
+		}
+
+		lambda.Docker.RestartCount = &restarts
+		lambda.Docker.Status = aggregateStatus(lambda.Docker.Replicas)
+		followReplicas(&lambda)
+
+		if reflect.DeepEqual(lambda, prev) {
+			return prev
+		}
+
+		if err := SetLambda(ctx, &lambda); err != nil {
+			updateErr = err
+			return prev
+		}
+
+		if lambda.Docker.Replicas[i].Status == CrashLoopStatus && prev.Docker.Replicas[i].Status != CrashLoopStatus {
+			crashLooped = lambda.Docker.Replicas[i].Container
+		}
+
+		return lambda
+	})
+
+	if crashLooped != "" {
+		reportEvent(ctx, id, CrashLoopEvent, "container "+crashLooped+" keeps failing, restarts are exhausted")
+	}
+
+	return updateErr
+}
+
+// resyncLambda inspects containers of the lambda replicas. It is called once the lambda is processed,
+// as statuses reported meanwhile are overwritten.
+func (s service) resyncLambda(ctx context.Context, id string) {
+	lambda := s.lambdas.Get(id, api.Lambda{})
+
+	for _, replica := range lambda.Docker.Replicas {
+		s.resyncReplica(ctx, id, replica.ContainerId)
+	}
+}
+
+func (s service) resyncReplica(ctx context.Context, id string, containerID string) {
+	state := s.inspectReplica(ctx, containerID)
+	update := func(lambda *api.Lambda, replica *api.Replica) {
+		applyState(lambda, replica, state)
+	}
+
+	if err := s.updateReplica(ctx, id, containerID, update); err != nil {
+		logger.L.Error(
+			"Failed to update lambda",
+			zap.Error(err),
+			zap.String("id", id),
+		)
+	}
+}
+
+// resync inspects containers of all lambdas which aren't being processed.
+func (s service) resync(ctx context.Context) {
+	for _, lambda := range s.lambdas.Values() {
+		if !deployed(&lambda) || stopped(&lambda) || s.starting.Has(lambda.Id) {
+			continue
+		}
+
+		s.resyncLambda(ctx, lambda.Id)
+	}
+}
+
+func (s service) resyncRoutine(ctx context.Context) {
+	for {
+		select {
+		case <-time.After(resyncInterval):
+		case <-ctx.Done():
+			return
+		}
+
+		s.resync(ctx)
+	}
+}
+
+// controlRoutine stops running lambdas once their idle timeout expires and autoscales them otherwise.
+func (s service) controlRoutine(ctx context.Context) {
+	for {
+		select {
+		case <-time.After(controlInterval):
+		case <-ctx.Done():
+			return
+		}
+
+		for _, lambda := range s.lambdas.Values() {
+			if !deployed(&lambda) || stopped(&lambda) || s.starting.Has(lambda.Id) {
+				continue
+			}
+
+			s.control(ctx, &lambda)
+		}
+	}
+}
+
+func (s service) control(ctx context.Context, lambda *api.Lambda) {
+	expired, err := idleExpired(ctx, lambda)
+	if err != nil {
+		logger.L.Error(
+			"Failed to check lambda activity",
+			zap.Error(err),
+			zap.String("id", lambda.Id),
+		)
+		return
+	}
+
+	if !expired {
+		s.autoscale(ctx, lambda)
+		return
+	}
+
+	if err := s.idle(ctx, lambda.Id); err != nil {
+		logger.L.Error(
+			"Failed to stop idle lambda",
+			zap.Error(err),
+			zap.String("id", lambda.Id),
+		)
+	}
+}
@@ -0,0 +1,140 @@
+package lambda
+
+import (
+	"testing"
+
+	api "github.com/hedlx/doless/client"
+	"github.com/hedlx/doless/manager/common"
+	"github.com/hedlx/doless/manager/docker"
+	"github.com/hedlx/doless/manager/model"
+)
+
+func TestEventUpdate(t *testing.T) {
+	maxRetries := int64(3)
+	onFailure := &api.LambdaRestartPolicy{Mode: model.RestartOnFailure, MaxRetries: &maxRetries}
+
+	tests := []struct {
+		name      string
+		action    string
+		policy    *api.LambdaRestartPolicy
+		state     replicaState
+		ignored   bool
+		inspected bool
+		status    string
+		exitCode  *int64
+	}{
+		{name: "healthy", action: "health_status: healthy", status: "healthy"},
+		{name: "unhealthy", action: "health_status: unhealthy", status: "unhealthy"},
+		{name: "destroyed", action: "destroy", status: RemovedStatus},
+		{name: "started", action: "start", state: replicaState{Status: "starting"}, inspected: true, status: "starting"},
+		{name: "unpaused", action: "unpause", state: replicaState{Status: "healthy"}, inspected: true, status: "healthy"},
+		{
+			name:      "died",
+			action:    "die",
+			state:     replicaState{Status: ExitedStatus, Exited: true, ExitCode: 137, OOMKilled: true},
+			inspected: true,
+			status:    ExitedStatus,
+			exitCode:  int64Ptr(137),
+		},
+		{
+			name:      "restarting",
+			action:    "die",
+			policy:    onFailure,
+			state:     replicaState{Status: "restarting", Exited: true, ExitCode: 1, RestartCount: 1},
+			inspected: true,
+			status:    "restarting",
+			exitCode:  int64Ptr(1),
+		},
+		{
+			name:      "restarts are exhausted",
+			action:    "die",
+			policy:    onFailure,
+			state:     replicaState{Status: ExitedStatus, Exited: true, ExitCode: 1, RestartCount: 3},
+			inspected: true,
+			status:    CrashLoopStatus,
+			exitCode:  int64Ptr(1),
+		},
+		// Died container is inspected once die event comes
+		{name: "oom", action: "oom", ignored: true},
+		{name: "created", action: "create", ignored: true},
+		{name: "exec", action: "exec_start: sh -c true", ignored: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inspected := false
+			update := eventUpdate(tt.action, func() replicaState {
+				inspected = true
+				return tt.state
+			})
+
+			if inspected != tt.inspected {
+				t.Errorf("expected inspected to be %v", tt.inspected)
+			}
+
+			if update == nil {
+				if !tt.ignored {
+					t.Fatal("action is ignored")
+				}
+				return
+			}
+
+			if tt.ignored {
+				t.Fatal("expected action to be ignored")
+			}
+
+			lambda := api.Lambda{RestartPolicy: tt.policy}
+			replica := api.Replica{Status: "healthy"}
+			update(&lambda, &replica)
+
+			if replica.Status != tt.status {
+				t.Errorf("expected status %s, got %s", tt.status, replica.Status)
+			}
+
+			if tt.exitCode == nil {
+				if replica.ExitCode != nil {
+					t.Errorf("unexpected exit code %d", *replica.ExitCode)
+				}
+				return
+			}
+
+			if replica.ExitCode == nil || *replica.ExitCode != *tt.exitCode {
+				t.Errorf("expected exit code %d, got %v", *tt.exitCode, replica.ExitCode)
+			}
+
+			if lambda.Docker.ExitCode == nil || *lambda.Docker.ExitCode != *tt.exitCode {
+				t.Errorf("expected lambda exit code %d, got %v", *tt.exitCode, lambda.Docker.ExitCode)
+			}
+		})
+	}
+}
+
+func TestEventLambda(t *testing.T) {
+	s := service{lambdas: common.CreateConcurrentMap[string, api.Lambda]()}
+	s.lambdas.Set("echo", api.Lambda{
+		Id:     "echo",
+		Docker: api.Docker{Replicas: []api.Replica{{ContainerId: "c1"}, {ContainerId: "c2"}}},
+	})
+
+	tests := []struct {
+		name  string
+		event docker.ContainerEvent
+		id    string
+	}{
+		{name: "labeled", event: docker.ContainerEvent{Lambda: "other", Container: "c1"}, id: "other"},
+		{name: "unlabeled replica", event: docker.ContainerEvent{Container: "c2"}, id: "echo"},
+		{name: "unknown container", event: docker.ContainerEvent{Container: "c3"}, id: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if id := s.eventLambda(tt.event); id != tt.id {
+				t.Errorf("expected lambda '%s', got '%s'", tt.id, id)
+			}
+		})
+	}
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
@@ -12,6 +13,8 @@ import (
 	"go.uber.org/zap"
 
 	api "github.com/hedlx/doless/client"
+	"github.com/hedlx/doless/manager/db"
+	"github.com/hedlx/doless/manager/docker"
 	"github.com/hedlx/doless/manager/endpoint"
 	"github.com/hedlx/doless/manager/lambda"
 	"github.com/hedlx/doless/manager/logger"
@@ -27,13 +30,18 @@ type Services struct {
 }
 
 func makeServices() *Services {
+	db.Connect()
+
 	lSvc, err := lambda.CreateLambdaService()
 	if err != nil {
-		panic(err)
+		logger.L.Fatal("Failed to create lambda service", zap.Error(err))
 	}
 
 	eSvc := endpoint.CreateEndpointService(lSvc)
-	tSvc := task.CreateTaskService()
+	tSvc, err := task.CreateTaskService(context.Background())
+	if err != nil {
+		panic(err)
+	}
 
 	return &Services{
 		taskSvc:     tSvc,
@@ -96,6 +104,58 @@ func StartServer(svcs *Services) (*http.Server, error) {
 		c.JSON(http.StatusCreated, gin.H{"id": id})
 	})
 
+	r.POST("/blob/missing", func(c *gin.Context) {
+		req := &api.BlobDigests{}
+		err := c.ShouldBind(req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		err = model.ValidateDigests(req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		missing, err := lambda.MissingBlobs(c, req.Digests)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, api.BlobDigests{Digests: missing})
+	})
+
+	r.POST("/blob/:digest", func(c *gin.Context) {
+		digest := c.Param("digest")
+		if err := model.ValidateDigest(digest); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		file, err := fileHeader.Open()
+		if err != nil {
+			logger.L.Error("internal server error", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		defer file.Close()
+
+		if err := lambda.UploadBlob(c, digest, file); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Status(http.StatusCreated)
+	})
+
 	r.GET("/lambda", func(c *gin.Context) {
 		lambdas, err := lambda.GetLambdas(c)
 
@@ -117,6 +177,89 @@ func StartServer(svcs *Services) (*http.Server, error) {
 		c.JSON(http.StatusOK, lambda)
 	})
 
+	r.GET("/lambda/:id/metrics", func(c *gin.Context) {
+		metrics, err := lambda.GetMetrics(c, c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		if metrics == nil {
+			// Handler hasn't routed any request to the lambda yet
+			metrics = &api.LambdaMetrics{}
+		}
+
+		c.JSON(http.StatusOK, metrics)
+	})
+
+	r.GET("/lambda/:id/logs", func(c *gin.Context) {
+		query := struct {
+			Since   string `form:"since"`
+			Tail    *int64 `form:"tail"`
+			Stdout  *bool  `form:"stdout"`
+			Stderr  *bool  `form:"stderr"`
+			Replica *int64 `form:"replica"`
+			Follow  bool   `form:"follow"`
+		}{}
+		if err := c.ShouldBindQuery(&query); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		opts := docker.LogsOptions{
+			Since:  query.Since,
+			Tail:   "all",
+			Stdout: query.Stdout == nil || *query.Stdout,
+			Stderr: query.Stderr == nil || *query.Stderr,
+			Follow: query.Follow,
+		}
+
+		if query.Tail != nil {
+			opts.Tail = strconv.FormatInt(*query.Tail, 10)
+		}
+
+		if !query.Follow {
+			lines := []api.LambdaLogLine{}
+			err := svcs.lambdaSvc.Logs(c, c.Param("id"), query.Replica, opts, func(line api.LambdaLogLine) {
+				lines = append(lines, line)
+			})
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+
+			c.JSON(http.StatusOK, lines)
+			return
+		}
+
+		c.Header("Cache-Control", "no-cache")
+		c.Header("X-Accel-Buffering", "no")
+
+		// Stream stops once client disconnects and request context is cancelled
+		err := svcs.lambdaSvc.Logs(c.Request.Context(), c.Param("id"), query.Replica, opts, func(line api.LambdaLogLine) {
+			c.SSEvent("log", line)
+			c.Writer.Flush()
+		})
+		if err != nil {
+			if !c.Writer.Written() {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+
+			c.SSEvent("error", gin.H{"error": err.Error()})
+		}
+	})
+
+	r.GET("/lambda/:id/events", func(c *gin.Context) {
+		events, err := lambda.GetEvents(c, c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, events)
+	})
+
 	r.POST("/lambda", func(c *gin.Context) {
 		cLambda := &api.CreateLambda{}
 		err := c.ShouldBind(cLambda)
@@ -125,7 +268,13 @@ func StartServer(svcs *Services) (*http.Server, error) {
 			return
 		}
 
-		err = model.ValidateCreateLambda(cLambda)
+		runtime, err := lambda.GetRuntime(c, cLambda.Runtime)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		err = model.ValidateCreateLambda(cLambda, runtime)
 		if err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
@@ -140,14 +289,208 @@ func StartServer(svcs *Services) (*http.Server, error) {
 		c.JSON(http.StatusCreated, lambda)
 	})
 
+	r.POST("/lambda/:id/version", func(c *gin.Context) {
+		cVersion := &api.CreateLambdaVersion{}
+		err := c.ShouldBind(cVersion)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		err = model.ValidateCreateLambdaVersion(cVersion)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		version, err := svcs.lambdaSvc.CreateVersion(c, c.Param("id"), cVersion)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusCreated, version)
+	})
+
+	r.PUT("/lambda/:id/env", func(c *gin.Context) {
+		req := &api.SetLambdaEnv{}
+		err := c.ShouldBind(req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		err = model.ValidateSetLambdaEnv(req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		lambdaID := c.Param("id")
+		id := util.UUID()
+		ctx := svcs.taskSvc.Add(id, "lambda.set_env", lambdaID)
+
+		go func() {
+			if err := svcs.lambdaSvc.SetEnv(ctx, lambdaID, req.Env); err != nil {
+				svcs.taskSvc.Failed(id, struct {
+					Error string `json:"error"`
+				}{Error: err.Error()})
+				return
+			}
+
+			svcs.taskSvc.Succeeded(id, nil)
+		}()
+
+		c.JSON(http.StatusAccepted, gin.H{"task": id})
+	})
+
+	r.PUT("/lambda/:id/replicas", func(c *gin.Context) {
+		req := &api.ScaleLambda{}
+		err := c.ShouldBind(req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		err = model.ValidateReplicas(req.Replicas)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		lambdaID := c.Param("id")
+		id := util.UUID()
+		ctx := svcs.taskSvc.Add(id, "lambda.scale", lambdaID)
+
+		go func() {
+			if err := svcs.lambdaSvc.Scale(ctx, lambdaID, req.Replicas); err != nil {
+				svcs.taskSvc.Failed(id, struct {
+					Error string `json:"error"`
+				}{Error: err.Error()})
+				return
+			}
+
+			svcs.taskSvc.Succeeded(id, nil)
+		}()
+
+		c.JSON(http.StatusAccepted, gin.H{"task": id})
+	})
+
+	r.PUT("/lambda/:id/alias/:alias", func(c *gin.Context) {
+		req := &api.SetLambdaAlias{}
+		err := c.ShouldBind(req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		err = model.ValidateAlias(c.Param("alias"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		lambda, err := svcs.lambdaSvc.SetAlias(c, c.Param("id"), c.Param("alias"), req.Version)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, lambda)
+	})
+
+	r.PUT("/lambda/:id/autoscaling", func(c *gin.Context) {
+		req := &api.SetLambdaAutoscaling{}
+		err := c.ShouldBind(req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		if req.Autoscaling != nil {
+			err = model.ValidateAutoscaling(req.Autoscaling)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+		}
+
+		lambda, err := svcs.lambdaSvc.SetAutoscaling(c, c.Param("id"), req.Autoscaling)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, lambda)
+	})
+
+	r.PUT("/lambda/:id/restart-policy", func(c *gin.Context) {
+		req := &api.SetLambdaRestartPolicy{}
+		err := c.ShouldBind(req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		if req.RestartPolicy != nil {
+			err = model.ValidateRestartPolicy(req.RestartPolicy)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+		}
+
+		lambda, err := svcs.lambdaSvc.SetRestartPolicy(c, c.Param("id"), req.RestartPolicy)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, lambda)
+	})
+
+	r.PUT("/lambda/:id/idle-timeout", func(c *gin.Context) {
+		req := &api.SetLambdaIdleTimeout{}
+		err := c.ShouldBind(req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		err = model.ValidateIdleTimeout(req.IdleTimeout)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		lambda, err := svcs.lambdaSvc.SetIdleTimeout(c, c.Param("id"), req.IdleTimeout)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, lambda)
+	})
+
+	r.DELETE("/lambda/:id/alias/:alias", func(c *gin.Context) {
+		lambda, err := svcs.lambdaSvc.DeleteAlias(c, c.Param("id"), c.Param("alias"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, lambda)
+	})
+
 	r.POST("/lambda/:id/start", func(c *gin.Context) {
+		lambdaID := c.Param("id")
 		id := util.UUID()
-		svcs.taskSvc.Add(id)
+		ctx := svcs.taskSvc.Add(id, "lambda.start", lambdaID)
 
 		go func() {
-			ctx := context.TODO()
+			progress := func(line string) { svcs.taskSvc.Progress(id, line) }
 
-			if err := svcs.lambdaSvc.Start(ctx, c.Param("id")); err != nil {
+			if err := svcs.lambdaSvc.Start(ctx, lambdaID, progress); err != nil {
 				svcs.taskSvc.Failed(id, struct {
 					Error string `json:"error"`
 				}{Error: err.Error()})
@@ -160,14 +503,105 @@ func StartServer(svcs *Services) (*http.Server, error) {
 		c.JSON(http.StatusAccepted, gin.H{"task": id})
 	})
 
-	r.POST("/lambda/:id/destroy", func(c *gin.Context) {
+	r.POST("/lambda/:id/wake", func(c *gin.Context) {
+		lambdaID := c.Param("id")
+		id := util.UUID()
+		ctx := svcs.taskSvc.Add(id, "lambda.wake", lambdaID)
+
+		go func() {
+			if err := svcs.lambdaSvc.Wake(ctx, lambdaID); err != nil {
+				svcs.taskSvc.Failed(id, struct {
+					Error string `json:"error"`
+				}{Error: err.Error()})
+				return
+			}
+
+			svcs.taskSvc.Succeeded(id, nil)
+		}()
+
+		c.JSON(http.StatusAccepted, gin.H{"task": id})
+	})
+
+	r.POST("/lambda/:id/stop", func(c *gin.Context) {
+		lambdaID := c.Param("id")
+		id := util.UUID()
+		ctx := svcs.taskSvc.Add(id, "lambda.stop", lambdaID)
+
+		go func() {
+			if err := svcs.lambdaSvc.StopLambda(ctx, lambdaID); err != nil {
+				svcs.taskSvc.Failed(id, struct {
+					Error string `json:"error"`
+				}{Error: err.Error()})
+				return
+			}
+
+			svcs.taskSvc.Succeeded(id, nil)
+		}()
+
+		c.JSON(http.StatusAccepted, gin.H{"task": id})
+	})
+
+	r.PUT("/lambda/:id/code", func(c *gin.Context) {
+		req := &api.UpdateLambdaCode{}
+		err := c.ShouldBind(req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		err = model.ValidateUpdateLambdaCode(req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		lambdaID := c.Param("id")
 		id := util.UUID()
-		svcs.taskSvc.Add(id)
+		ctx := svcs.taskSvc.Add(id, "lambda.update_code", lambdaID)
 
 		go func() {
-			ctx := context.TODO()
+			progress := func(line string) { svcs.taskSvc.Progress(id, line) }
 
-			if err := svcs.lambdaSvc.Destroy(ctx, c.Param("id")); err != nil {
+			if err := svcs.lambdaSvc.UpdateCode(ctx, lambdaID, req, progress); err != nil {
+				svcs.taskSvc.Failed(id, struct {
+					Error string `json:"error"`
+				}{Error: err.Error()})
+				return
+			}
+
+			svcs.taskSvc.Succeeded(id, nil)
+		}()
+
+		c.JSON(http.StatusAccepted, gin.H{"task": id})
+	})
+
+	r.DELETE("/lambda/:id", func(c *gin.Context) {
+		lambdaID := c.Param("id")
+		cascade := c.Query("cascade") == "true"
+		id := util.UUID()
+		ctx := svcs.taskSvc.Add(id, "lambda.delete", lambdaID)
+
+		go func() {
+			if err := svcs.lambdaSvc.Delete(ctx, lambdaID, cascade); err != nil {
+				svcs.taskSvc.Failed(id, struct {
+					Error string `json:"error"`
+				}{Error: err.Error()})
+				return
+			}
+
+			svcs.taskSvc.Succeeded(id, nil)
+		}()
+
+		c.JSON(http.StatusAccepted, gin.H{"task": id})
+	})
+
+	r.POST("/lambda/:id/destroy", func(c *gin.Context) {
+		lambdaID := c.Param("id")
+		id := util.UUID()
+		ctx := svcs.taskSvc.Add(id, "lambda.destroy", lambdaID)
+
+		go func() {
+			if err := svcs.lambdaSvc.Destroy(ctx, lambdaID); err != nil {
 				svcs.taskSvc.Failed(id, struct {
 					Error string `json:"error"`
 				}{Error: err.Error()})
@@ -220,9 +654,90 @@ func StartServer(svcs *Services) (*http.Server, error) {
 			return
 		}
 
+		if runtime.BaseImage != nil {
+			buildRuntimeBase(svcs, runtime.Id)
+		}
+
 		c.JSON(http.StatusCreated, runtime)
 	})
 
+	r.PUT("/runtime/:id", func(c *gin.Context) {
+		req := &api.UpdateRuntime{}
+		err := c.ShouldBind(req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		err = model.ValidateUpdateRuntime(req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		runtime, err := svcs.lambdaSvc.UpdateRuntime(c, c.Param("id"), req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		if req.BaseDockerfile != nil {
+			buildRuntimeBase(svcs, runtime.Id)
+		}
+
+		c.JSON(http.StatusOK, runtime)
+	})
+
+	r.POST("/runtime/:id/rollout", func(c *gin.Context) {
+		batch, err := strconv.Atoi(c.DefaultQuery("batch", "1"))
+		if err != nil || batch < 1 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "'batch' must be a positive integer"})
+			return
+		}
+
+		runtimeID := c.Param("id")
+		id := util.UUID()
+		ctx := svcs.taskSvc.Add(id, "runtime.rollout", runtimeID)
+
+		go func() {
+			progress := func(line string) { svcs.taskSvc.Progress(id, line) }
+
+			if err := svcs.lambdaSvc.RolloutRuntime(ctx, runtimeID, batch, progress); err != nil {
+				svcs.taskSvc.Failed(id, struct {
+					Error string `json:"error"`
+				}{Error: err.Error()})
+				return
+			}
+
+			svcs.taskSvc.Succeeded(id, nil)
+		}()
+
+		c.JSON(http.StatusAccepted, gin.H{"task": id})
+	})
+
+	r.POST("/runtime/:id/build", func(c *gin.Context) {
+		c.JSON(http.StatusAccepted, gin.H{"task": buildRuntimeBase(svcs, c.Param("id"))})
+	})
+
+	r.DELETE("/runtime/:id", func(c *gin.Context) {
+		if err := svcs.lambdaSvc.DeleteRuntime(c, c.Param("id")); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Status(http.StatusOK)
+	})
+
+	r.GET("/runtime/:id/lambdas", func(c *gin.Context) {
+		lambdas, err := lambda.GetRuntimeLambdas(c, c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, lambdas)
+	})
+
 	r.GET("/endpoint", func(c *gin.Context) {
 		endpoints, err := svcs.endpointSvc.List(c)
 		if err != nil {
@@ -266,15 +781,126 @@ func StartServer(svcs *Services) (*http.Server, error) {
 		c.JSON(http.StatusCreated, endpoint)
 	})
 
+	r.PATCH("/endpoint/:id", func(c *gin.Context) {
+		req := &api.UpdateEndpoint{}
+		err := c.ShouldBind(req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		err = model.ValidateUpdateEndpoint(req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		endpoint, err := svcs.endpointSvc.Update(c, c.Param("id"), req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, endpoint)
+	})
+
+	r.DELETE("/endpoint/:id", func(c *gin.Context) {
+		if err := svcs.endpointSvc.Delete(c, c.Param("id")); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Status(http.StatusOK)
+	})
+
+	r.GET("/task", func(c *gin.Context) {
+		limit, err := strconv.Atoi(c.DefaultQuery("limit", "100"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		tasks, err := svcs.taskSvc.List(c, task.Filter{
+			Kind:   c.Query("kind"),
+			Target: c.Query("target"),
+			Status: c.Query("status"),
+			Limit:  limit,
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, tasks)
+	})
+
+	r.POST("/task/:id/cancel", func(c *gin.Context) {
+		id := c.Param("id")
+		if svcs.taskSvc.Get(c, id) == nil {
+			c.Status(http.StatusNotFound)
+			return
+		}
+
+		if err := svcs.taskSvc.Cancel(id); err != nil {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusAccepted, task.PrepareStatus(svcs.taskSvc.Get(c, id), 0))
+	})
+
 	r.GET("/task/:id", func(c *gin.Context) {
-		status := svcs.taskSvc.Get(c.Param("id"))
+		offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		status := svcs.taskSvc.Get(c, c.Param("id"))
 
 		if status == nil {
 			c.Status(http.StatusNotFound)
 			return
 		}
 
-		c.JSON(http.StatusOK, task.PrepareStatus(status))
+		c.JSON(http.StatusOK, task.PrepareStatus(status, offset))
+	})
+
+	r.GET("/task/:id/watch", func(c *gin.Context) {
+		id := c.Param("id")
+		if svcs.taskSvc.Get(c, id) == nil {
+			c.Status(http.StatusNotFound)
+			return
+		}
+
+		c.Header("Cache-Control", "no-cache")
+		c.Header("X-Accel-Buffering", "no")
+
+		// Every event carries only progress lines which weren't sent yet
+		sent := 0
+		for {
+			// Channel is taken before the status, so no change is missed in between
+			changed := svcs.taskSvc.Changed(id)
+			status := svcs.taskSvc.Get(c, id)
+			if status == nil {
+				return
+			}
+
+			c.SSEvent("status", task.PrepareStatus(status, sent))
+			c.Writer.Flush()
+
+			if !status.Pending() {
+				return
+			}
+
+			sent = len(status.Progress())
+
+			select {
+			case <-changed:
+			case <-c.Request.Context().Done():
+				return
+			}
+		}
 	})
 
 	srv := &http.Server{
@@ -290,3 +916,24 @@ func StartServer(svcs *Services) (*http.Server, error) {
 
 	return srv, nil
 }
+
+// buildRuntimeBase builds base image of the runtime in the background, returned task tracks the build.
+func buildRuntimeBase(svcs *Services, runtimeID string) string {
+	id := util.UUID()
+	ctx := svcs.taskSvc.Add(id, "runtime.build", runtimeID)
+
+	go func() {
+		progress := func(line string) { svcs.taskSvc.Progress(id, line) }
+
+		if err := svcs.lambdaSvc.BuildRuntimeBase(ctx, runtimeID, progress); err != nil {
+			svcs.taskSvc.Failed(id, struct {
+				Error string `json:"error"`
+			}{Error: err.Error()})
+			return
+		}
+
+		svcs.taskSvc.Succeeded(id, nil)
+	}()
+
+	return id
+}
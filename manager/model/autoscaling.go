@@ -0,0 +1,52 @@
+package model
+
+import (
+	"fmt"
+
+	api "github.com/hedlx/doless/client"
+	"github.com/hedlx/doless/manager/util"
+)
+
+var (
+	DefaultScaleUpCooldown   = int64(util.GetIntVarDefault("LAMBDA_DEFAULT_SCALE_UP_COOLDOWN", 30))
+	DefaultScaleDownCooldown = int64(util.GetIntVarDefault("LAMBDA_DEFAULT_SCALE_DOWN_COOLDOWN", 300))
+)
+
+func ValidateAutoscaling(as *api.LambdaAutoscaling) error {
+	if as.MinReplicas < 1 || as.MinReplicas > as.MaxReplicas || as.MaxReplicas > MaxReplicas {
+		return fmt.Errorf("replicas must satisfy 1 <= 'min_replicas' <= 'max_replicas' <= %d", MaxReplicas)
+	}
+
+	if as.TargetConcurrency < 1 {
+		return fmt.Errorf("'target_concurrency' must be positive")
+	}
+
+	if as.TargetLatency != nil && *as.TargetLatency < 1 {
+		return fmt.Errorf("'target_latency' must be positive")
+	}
+
+	if as.ScaleUpCooldown != nil && *as.ScaleUpCooldown < 0 {
+		return fmt.Errorf("'scale_up_cooldown' must not be negative")
+	}
+
+	if as.ScaleDownCooldown != nil && *as.ScaleDownCooldown < 0 {
+		return fmt.Errorf("'scale_down_cooldown' must not be negative")
+	}
+
+	return nil
+}
+
+// WithDefaultAutoscaling fills missing cooldowns with platform defaults.
+func WithDefaultAutoscaling(as api.LambdaAutoscaling) api.LambdaAutoscaling {
+	if as.ScaleUpCooldown == nil {
+		cooldown := DefaultScaleUpCooldown
+		as.ScaleUpCooldown = &cooldown
+	}
+
+	if as.ScaleDownCooldown == nil {
+		cooldown := DefaultScaleDownCooldown
+		as.ScaleDownCooldown = &cooldown
+	}
+
+	return as
+}
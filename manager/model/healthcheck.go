@@ -0,0 +1,30 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+
+	api "github.com/hedlx/doless/client"
+)
+
+// HealthcheckPathRegex allows only URL path and query characters, as the path is requested from the container shell.
+var HealthcheckPathRegex = regexp.MustCompile(`^/[A-Za-z0-9._~/%-]*(\?[A-Za-z0-9._~=&%-]*)?$`)
+
+func ValidateHealthcheck(healthcheck *api.RuntimeHealthcheck) error {
+	if !HealthcheckPathRegex.MatchString(healthcheck.Path) {
+		return fmt.Errorf("'path' '%s' doesn't conform regex: %s", healthcheck.Path, HealthcheckPathRegex.String())
+	}
+
+	for name, value := range map[string]*int64{
+		"interval":     healthcheck.Interval,
+		"timeout":      healthcheck.Timeout,
+		"retries":      healthcheck.Retries,
+		"start_period": healthcheck.StartPeriod,
+	} {
+		if value != nil && *value < 0 {
+			return fmt.Errorf("'%s' must not be negative", name)
+		}
+	}
+
+	return nil
+}
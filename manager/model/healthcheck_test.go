@@ -0,0 +1,43 @@
+package model
+
+import (
+	"testing"
+
+	api "github.com/hedlx/doless/client"
+)
+
+func TestValidateHealthcheck(t *testing.T) {
+	negative := int64(-1)
+
+	tests := []struct {
+		name        string
+		healthcheck api.RuntimeHealthcheck
+		invalid     bool
+	}{
+		{name: "root", healthcheck: api.RuntimeHealthcheck{Path: "/"}},
+		{name: "path", healthcheck: api.RuntimeHealthcheck{Path: "/health/live-check_v1.0~%20"}},
+		{name: "query", healthcheck: api.RuntimeHealthcheck{Path: "/health?full=true&timeout=5"}},
+		{name: "empty", healthcheck: api.RuntimeHealthcheck{Path: ""}, invalid: true},
+		{name: "relative", healthcheck: api.RuntimeHealthcheck{Path: "health"}, invalid: true},
+		{name: "newline", healthcheck: api.RuntimeHealthcheck{Path: "/health\ntouch /tmp/pwned"}, invalid: true},
+		{name: "tab", healthcheck: api.RuntimeHealthcheck{Path: "/health\t-o/tmp/pwned"}, invalid: true},
+		{name: "subshell", healthcheck: api.RuntimeHealthcheck{Path: "/$(touch /tmp/pwned)"}, invalid: true},
+		{name: "parentheses", healthcheck: api.RuntimeHealthcheck{Path: "/(touch)"}, invalid: true},
+		{name: "backticks", healthcheck: api.RuntimeHealthcheck{Path: "/`touch /tmp/pwned`"}, invalid: true},
+		{name: "glob", healthcheck: api.RuntimeHealthcheck{Path: "/*"}, invalid: true},
+		{name: "query glob", healthcheck: api.RuntimeHealthcheck{Path: "/health?*"}, invalid: true},
+		{name: "second query", healthcheck: api.RuntimeHealthcheck{Path: "/health?a=1?b=2"}, invalid: true},
+		{name: "separator", healthcheck: api.RuntimeHealthcheck{Path: "/health;reboot"}, invalid: true},
+		{name: "quote", healthcheck: api.RuntimeHealthcheck{Path: "/health'"}, invalid: true},
+		{name: "negative interval", healthcheck: api.RuntimeHealthcheck{Path: "/", Interval: &negative}, invalid: true},
+	}
+
+	for _, test := range tests {
+		err := ValidateHealthcheck(&test.healthcheck)
+		if test.invalid && err == nil {
+			t.Errorf("%s: expected error", test.name)
+		} else if !test.invalid && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+	}
+}
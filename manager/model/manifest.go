@@ -0,0 +1,105 @@
+package model
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+
+	api "github.com/hedlx/doless/client"
+	"github.com/hedlx/doless/manager/archive"
+)
+
+// DigestRegex matches hex encoded sha256 digests naming lambda source blobs.
+var DigestRegex = regexp.MustCompile("^[a-f0-9]{64}$")
+
+func ValidateDigest(digest string) error {
+	if !DigestRegex.MatchString(digest) {
+		return fmt.Errorf("digest '%s' doesn't conform regex: %s", digest, DigestRegex.String())
+	}
+
+	return nil
+}
+
+func ValidateDigests(digests *api.BlobDigests) error {
+	for _, digest := range digests.Digests {
+		if err := ValidateDigest(digest); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateSources checks that lambda sources are passed either as the archive or as the manifest.
+func ValidateSources(archive *string, manifest []api.LambdaManifestFile) error {
+	if archive != nil && manifest != nil {
+		return fmt.Errorf("either 'archive' or 'manifest' is expected")
+	}
+
+	if archive != nil {
+		if *archive == "" {
+			return fmt.Errorf("'archive' is required")
+		}
+
+		return nil
+	}
+
+	if len(manifest) == 0 {
+		return fmt.Errorf("'archive' or 'manifest' is required")
+	}
+
+	for _, file := range manifest {
+		if file.Path == "" {
+			return fmt.Errorf("'path' of manifest file is required")
+		}
+
+		if err := ValidateDigest(file.Digest); err != nil {
+			return fmt.Errorf("manifest file %s: %w", file.Path, err)
+		}
+	}
+
+	return nil
+}
+
+// CleanManifest checks the manifest against the same limits and path rules as archives
+// and returns it with paths relative to the lambda root.
+func CleanManifest(manifest []api.LambdaManifestFile) ([]api.LambdaManifestFile, error) {
+	if len(manifest) > archive.MaxFiles {
+		return nil, fmt.Errorf("invalid manifest: more than %d files", archive.MaxFiles)
+	}
+
+	files := []api.LambdaManifestFile{}
+	paths := map[string]bool{}
+
+	for _, file := range manifest {
+		if err := ValidateDigest(file.Digest); err != nil {
+			return nil, fmt.Errorf("invalid manifest: file %s: %w", file.Path, err)
+		}
+
+		name, err := archive.CleanPath(file.Path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid manifest: %w", err)
+		}
+
+		if name == "" {
+			return nil, fmt.Errorf("invalid manifest: path %s is not a file", file.Path)
+		}
+
+		if paths[name] {
+			return nil, fmt.Errorf("invalid manifest: path %s is duplicated", file.Path)
+		}
+		paths[name] = true
+
+		files = append(files, api.LambdaManifestFile{Path: name, Digest: file.Digest})
+	}
+
+	for name := range paths {
+		for dir := path.Dir(name); dir != "."; dir = path.Dir(dir) {
+			if paths[dir] {
+				return nil, fmt.Errorf("invalid manifest: path %s is inside of file %s", name, dir)
+			}
+		}
+	}
+
+	return files, nil
+}
@@ -0,0 +1,166 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	api "github.com/hedlx/doless/client"
+	"github.com/hedlx/doless/manager/archive"
+)
+
+var (
+	digestA = strings.Repeat("a", 64)
+	digestB = strings.Repeat("b", 64)
+)
+
+func TestCleanManifest(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest []api.LambdaManifestFile
+		expected []api.LambdaManifestFile
+		invalid  bool
+	}{
+		{
+			name: "valid",
+			manifest: []api.LambdaManifestFile{
+				{Path: "./main.py", Digest: digestA},
+				{Path: "lib//util.py", Digest: digestB},
+				{Path: "util.py", Digest: digestB},
+			},
+			expected: []api.LambdaManifestFile{
+				{Path: "main.py", Digest: digestA},
+				{Path: "lib/util.py", Digest: digestB},
+				{Path: "util.py", Digest: digestB},
+			},
+		},
+		{
+			name:     "duplicated path",
+			manifest: []api.LambdaManifestFile{{Path: "main.py", Digest: digestA}, {Path: "main.py", Digest: digestB}},
+			invalid:  true,
+		},
+		{
+			name:     "duplicated path after cleaning",
+			manifest: []api.LambdaManifestFile{{Path: "lib/main.py", Digest: digestA}, {Path: "./lib/../lib/main.py", Digest: digestB}},
+			invalid:  true,
+		},
+		{
+			name:     "path inside of file",
+			manifest: []api.LambdaManifestFile{{Path: "lib", Digest: digestA}, {Path: "lib/util.py", Digest: digestB}},
+			invalid:  true,
+		},
+		{
+			name:     "short digest",
+			manifest: []api.LambdaManifestFile{{Path: "main.py", Digest: "abc"}},
+			invalid:  true,
+		},
+		{
+			name:     "uppercase digest",
+			manifest: []api.LambdaManifestFile{{Path: "main.py", Digest: strings.ToUpper(digestA)}},
+			invalid:  true,
+		},
+		{
+			name:     "digest path",
+			manifest: []api.LambdaManifestFile{{Path: "main.py", Digest: "../" + digestA[3:]}},
+			invalid:  true,
+		},
+		{
+			name:     "parent traversal",
+			manifest: []api.LambdaManifestFile{{Path: "../main.py", Digest: digestA}},
+			invalid:  true,
+		},
+		{
+			name:     "nested parent traversal",
+			manifest: []api.LambdaManifestFile{{Path: "lib/../../main.py", Digest: digestA}},
+			invalid:  true,
+		},
+		{
+			name:     "absolute path",
+			manifest: []api.LambdaManifestFile{{Path: "/etc/passwd", Digest: digestA}},
+			invalid:  true,
+		},
+		{
+			name:     "root path",
+			manifest: []api.LambdaManifestFile{{Path: "./", Digest: digestA}},
+			invalid:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files, err := CleanManifest(test.manifest)
+			if test.invalid {
+				if err == nil {
+					t.Fatalf("expected error, got %v", files)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(files) != len(test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, files)
+			}
+
+			for i, file := range files {
+				if file != test.expected[i] {
+					t.Errorf("expected %v, got %v", test.expected[i], file)
+				}
+			}
+		})
+	}
+}
+
+func TestCleanManifestLimits(t *testing.T) {
+	prevFiles, prevDepth := archive.MaxFiles, archive.MaxPathDepth
+	archive.MaxFiles, archive.MaxPathDepth = 2, 2
+	t.Cleanup(func() {
+		archive.MaxFiles, archive.MaxPathDepth = prevFiles, prevDepth
+	})
+
+	files := []api.LambdaManifestFile{{Path: "a.py", Digest: digestA}, {Path: "b.py", Digest: digestA}, {Path: "c.py", Digest: digestA}}
+	if _, err := CleanManifest(files); err == nil {
+		t.Error("expected file count error")
+	}
+
+	files = []api.LambdaManifestFile{{Path: "a/b/c.py", Digest: digestA}}
+	if _, err := CleanManifest(files); err == nil {
+		t.Error("expected path depth error")
+	}
+
+	files = []api.LambdaManifestFile{{Path: "a/b.py", Digest: digestA}, {Path: "c.py", Digest: digestA}}
+	if _, err := CleanManifest(files); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestValidateSources(t *testing.T) {
+	archiveID := "archive"
+	empty := ""
+
+	tests := []struct {
+		name     string
+		archive  *string
+		manifest []api.LambdaManifestFile
+		invalid  bool
+	}{
+		{name: "archive", archive: &archiveID},
+		{name: "manifest", manifest: []api.LambdaManifestFile{{Path: "main.py", Digest: digestA}}},
+		{name: "none", invalid: true},
+		{name: "both", archive: &archiveID, manifest: []api.LambdaManifestFile{{Path: "main.py", Digest: digestA}}, invalid: true},
+		{name: "empty archive", archive: &empty, invalid: true},
+		{name: "empty manifest", manifest: []api.LambdaManifestFile{}, invalid: true},
+		{name: "empty path", manifest: []api.LambdaManifestFile{{Digest: digestA}}, invalid: true},
+		{name: "bad digest", manifest: []api.LambdaManifestFile{{Path: "main.py", Digest: "sha256:" + digestA}}, invalid: true},
+	}
+
+	for _, test := range tests {
+		err := ValidateSources(test.archive, test.manifest)
+		if test.invalid && err == nil {
+			t.Errorf("%s: expected error", test.name)
+		} else if !test.invalid && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+	}
+}
@@ -7,11 +7,16 @@ import (
 	api "github.com/hedlx/doless/client"
 )
 
-func ValidateCreateLambda(lambda *api.CreateLambda) error {
+// ValidateCreateLambda validates the lambda, its build args are validated against parameters of the runtime if it exists.
+func ValidateCreateLambda(lambda *api.CreateLambda, runtime *api.Runtime) error {
 	if lambda.Name == "" {
 		return fmt.Errorf("'name' is required")
 	}
 
+	if err := ValidateLambdaName(lambda.Name); err != nil {
+		return err
+	}
+
 	if lambda.Runtime == "" {
 		return fmt.Errorf("'runtime' is required")
 	}
@@ -24,6 +29,109 @@ func ValidateCreateLambda(lambda *api.CreateLambda) error {
 		return fmt.Errorf("invalid 'lambda_type' value: %s", lambda.LambdaType)
 	}
 
+	if err := ValidateSources(lambda.Archive, lambda.Manifest); err != nil {
+		return err
+	}
+
+	if lambda.Resources != nil {
+		if err := ValidateResources(lambda.Resources); err != nil {
+			return err
+		}
+	}
+
+	if lambda.Replicas != nil {
+		if err := ValidateReplicas(*lambda.Replicas); err != nil {
+			return err
+		}
+	}
+
+	if lambda.IdleTimeout != nil {
+		if err := ValidateIdleTimeout(*lambda.IdleTimeout); err != nil {
+			return err
+		}
+	}
+
+	if lambda.Autoscaling != nil {
+		if err := ValidateAutoscaling(lambda.Autoscaling); err != nil {
+			return err
+		}
+	}
+
+	if lambda.RestartPolicy != nil {
+		if err := ValidateRestartPolicy(lambda.RestartPolicy); err != nil {
+			return err
+		}
+	}
+
+	if runtime != nil {
+		if _, err := ResolveBuildArgs(lambda.BuildArgs, runtime.Parameters); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func ValidateCreateLambdaVersion(version *api.CreateLambdaVersion) error {
+	return ValidateSources(version.Archive, version.Manifest)
+}
+
+func ValidateUpdateLambdaCode(req *api.UpdateLambdaCode) error {
+	return ValidateSources(req.Archive, req.Manifest)
+}
+
+// EnvNameRegex matches names which are portable across shells
+var EnvNameRegex = regexp.MustCompile("^[A-Za-z_][A-Za-z0-9_]*$")
+
+func ValidateSetLambdaEnv(req *api.SetLambdaEnv) error {
+	names := map[string]bool{}
+
+	for _, v := range req.Env {
+		if !EnvNameRegex.MatchString(v.Name) {
+			return fmt.Errorf("env name '%s' doesn't conform regex: %s", v.Name, EnvNameRegex.String())
+		}
+
+		if names[v.Name] {
+			return fmt.Errorf("env name is duplicated: %s", v.Name)
+		}
+
+		names[v.Name] = true
+	}
+
+	return nil
+}
+
+// LambdaNameRegex keeps lambda names usable in image and container names and as hostnames.
+// Dots are not allowed, so "<alias>.<name>" alias hosts can't collide with lambda names.
+var LambdaNameRegex = regexp.MustCompile("^[a-z0-9]+(-[a-z0-9]+)*$")
+
+// MaxLambdaNameLength is the limit of a hostname label
+const MaxLambdaNameLength = 63
+
+func ValidateLambdaName(name string) error {
+	if !LambdaNameRegex.MatchString(name) {
+		return fmt.Errorf("'name' doesn't conform regex: %s", LambdaNameRegex.String())
+	}
+
+	if len(name) > MaxLambdaNameLength {
+		return fmt.Errorf("'name' is longer than %d characters", MaxLambdaNameLength)
+	}
+
+	return nil
+}
+
+// AliasRegex keeps aliases usable as a part of container hostname
+var AliasRegex = regexp.MustCompile("^[a-z0-9]+(-[a-z0-9]+)*$")
+
+func ValidateAlias(alias string) error {
+	if !AliasRegex.MatchString(alias) {
+		return fmt.Errorf("'alias' doesn't conform regex: %s", AliasRegex.String())
+	}
+
+	if len(alias) > MaxLambdaNameLength {
+		return fmt.Errorf("'alias' is longer than %d characters", MaxLambdaNameLength)
+	}
+
 	return nil
 }
 
@@ -46,7 +154,27 @@ func ValidateCreateRuntime(req *api.CreateRuntime) error {
 		return fmt.Errorf("'dockerfile' is required")
 	}
 
-	return nil
+	if req.Healthcheck != nil {
+		if err := ValidateHealthcheck(req.Healthcheck); err != nil {
+			return err
+		}
+	}
+
+	return ValidateRuntimeParameters(req.Parameters)
+}
+
+func ValidateUpdateRuntime(req *api.UpdateRuntime) error {
+	if req.Dockerfile == "" {
+		return fmt.Errorf("'dockerfile' is required")
+	}
+
+	if req.Healthcheck != nil {
+		if err := ValidateHealthcheck(req.Healthcheck); err != nil {
+			return err
+		}
+	}
+
+	return ValidateRuntimeParameters(req.Parameters)
 }
 
 func ValidateCreateEndpoint(req *api.CreateEndpoint) error {
@@ -62,5 +190,43 @@ func ValidateCreateEndpoint(req *api.CreateEndpoint) error {
 		return err
 	}
 
+	if req.Alias != nil {
+		if err := ValidateAlias(*req.Alias); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func ValidateUpdateEndpoint(req *api.UpdateEndpoint) error {
+	if req.Name != nil && *req.Name == "" {
+		return fmt.Errorf("'name' must not be empty")
+	}
+
+	if req.Lambda != nil && *req.Lambda == "" {
+		return fmt.Errorf("'lambda' must not be empty")
+	}
+
+	if req.Path != nil {
+		if err := ValidateEndpoint(*req.Path); err != nil {
+			return err
+		}
+	}
+
+	if req.Alias != nil && *req.Alias != "" {
+		if err := ValidateAlias(*req.Alias); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func ValidateIdleTimeout(timeout int64) error {
+	if timeout < 0 {
+		return fmt.Errorf("'idle_timeout' must not be negative")
+	}
+
 	return nil
 }
@@ -0,0 +1,119 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	api "github.com/hedlx/doless/client"
+	"github.com/samber/lo"
+)
+
+const (
+	StringParameter  = "string"
+	IntegerParameter = "integer"
+	BooleanParameter = "boolean"
+)
+
+// BaseImageArg is the build arg passing runtime base image to lambda builds, parameters can't take it.
+const BaseImageArg = "BASE_IMAGE"
+
+var parameterNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func ValidateRuntimeParameters(params []api.RuntimeParameter) error {
+	names := map[string]bool{}
+
+	for _, param := range params {
+		if !parameterNameRegexp.MatchString(param.Name) || param.Name == BaseImageArg {
+			return fmt.Errorf("invalid parameter name: %s", param.Name)
+		}
+
+		if names[param.Name] {
+			return fmt.Errorf("duplicate parameter: %s", param.Name)
+		}
+		names[param.Name] = true
+
+		if param.Kind != StringParameter && param.Kind != IntegerParameter && param.Kind != BooleanParameter {
+			return fmt.Errorf("'kind' of parameter %s must be one of: %s, %s, %s", param.Name, StringParameter, IntegerParameter, BooleanParameter)
+		}
+
+		for _, value := range param.Values {
+			if err := validateParameterValue(param.Kind, value); err != nil {
+				return fmt.Errorf("invalid value of parameter %s: %w", param.Name, err)
+			}
+		}
+
+		if param.Default != nil {
+			if err := ValidateParameterValue(&param, *param.Default); err != nil {
+				return fmt.Errorf("invalid default: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateParameterValue checks that the value is of the parameter kind and is one of its values if they are listed.
+func ValidateParameterValue(param *api.RuntimeParameter, value string) error {
+	if err := validateParameterValue(param.Kind, value); err != nil {
+		return fmt.Errorf("parameter %s: %w", param.Name, err)
+	}
+
+	if len(param.Values) > 0 && !lo.Contains(param.Values, value) {
+		return fmt.Errorf("parameter %s must be one of: %v", param.Name, param.Values)
+	}
+
+	return nil
+}
+
+func validateParameterValue(kind string, value string) error {
+	switch kind {
+	case IntegerParameter:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("'%s' is not an integer", value)
+		}
+	case BooleanParameter:
+		if value != "true" && value != "false" {
+			return fmt.Errorf("'%s' is not a boolean", value)
+		}
+	}
+
+	return nil
+}
+
+// ResolveBuildArgs validates build args of the lambda against the runtime parameters
+// and returns values of all parameters, defaults are taken for the omitted ones.
+func ResolveBuildArgs(args []api.LambdaBuildArg, params []api.RuntimeParameter) (map[string]string, error) {
+	values := map[string]string{}
+
+	for _, arg := range args {
+		param, exists := lo.Find(params, func(p api.RuntimeParameter) bool { return p.Name == arg.Name })
+		if !exists {
+			return nil, fmt.Errorf("runtime has no parameter %s", arg.Name)
+		}
+
+		if _, duplicate := values[arg.Name]; duplicate {
+			return nil, fmt.Errorf("duplicate build arg: %s", arg.Name)
+		}
+
+		if err := ValidateParameterValue(&param, arg.Value); err != nil {
+			return nil, err
+		}
+
+		values[arg.Name] = arg.Value
+	}
+
+	for _, param := range params {
+		if _, set := values[param.Name]; set {
+			continue
+		}
+
+		if param.Default == nil {
+			return nil, fmt.Errorf("parameter %s is required", param.Name)
+		}
+
+		values[param.Name] = *param.Default
+	}
+
+	return values, nil
+}
@@ -0,0 +1,194 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+
+	api "github.com/hedlx/doless/client"
+)
+
+func param(name string, kind string, def *string, values ...string) api.RuntimeParameter {
+	return api.RuntimeParameter{Name: name, Kind: kind, Default: def, Values: values}
+}
+
+func str(s string) *string {
+	return &s
+}
+
+func TestValidateParameterValue(t *testing.T) {
+	tests := []struct {
+		param   api.RuntimeParameter
+		value   string
+		invalid bool
+	}{
+		{param: param("NAME", StringParameter, nil), value: "any value"},
+		{param: param("NAME", StringParameter, nil), value: ""},
+		{param: param("WORKERS", IntegerParameter, nil), value: "4"},
+		{param: param("WORKERS", IntegerParameter, nil), value: "-4"},
+		{param: param("WORKERS", IntegerParameter, nil), value: "+4"},
+		{param: param("WORKERS", IntegerParameter, nil), value: "4.5", invalid: true},
+		{param: param("WORKERS", IntegerParameter, nil), value: " 4", invalid: true},
+		{param: param("WORKERS", IntegerParameter, nil), value: "four", invalid: true},
+		{param: param("WORKERS", IntegerParameter, nil), value: "", invalid: true},
+		{param: param("WORKERS", IntegerParameter, nil), value: "9223372036854775808", invalid: true},
+		{param: param("DEBUG", BooleanParameter, nil), value: "true"},
+		{param: param("DEBUG", BooleanParameter, nil), value: "false"},
+		{param: param("DEBUG", BooleanParameter, nil), value: "TRUE", invalid: true},
+		{param: param("DEBUG", BooleanParameter, nil), value: "1", invalid: true},
+		{param: param("DEBUG", BooleanParameter, nil), value: "", invalid: true},
+		{param: param("VERSION", StringParameter, nil, "3.10", "3.11"), value: "3.11"},
+		{param: param("VERSION", StringParameter, nil, "3.10", "3.11"), value: "3.12", invalid: true},
+		{param: param("WORKERS", IntegerParameter, nil, "1", "2"), value: "02", invalid: true},
+	}
+
+	for _, test := range tests {
+		err := ValidateParameterValue(&test.param, test.value)
+		if test.invalid && err == nil {
+			t.Errorf("%s %s %q: expected error", test.param.Kind, test.param.Name, test.value)
+		} else if !test.invalid && err != nil {
+			t.Errorf("%s %s %q: unexpected error: %v", test.param.Kind, test.param.Name, test.value, err)
+		}
+	}
+}
+
+func TestValidateRuntimeParameters(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  []api.RuntimeParameter
+		invalid bool
+	}{
+		{name: "none"},
+		{
+			name: "valid",
+			params: []api.RuntimeParameter{
+				param("PYTHON_VERSION", StringParameter, str("3.11"), "3.10", "3.11"),
+				param("WORKERS", IntegerParameter, str("2")),
+				param("_DEBUG", BooleanParameter, nil),
+			},
+		},
+		{name: "empty name", params: []api.RuntimeParameter{param("", StringParameter, nil)}, invalid: true},
+		{name: "name with dash", params: []api.RuntimeParameter{param("PYTHON-VERSION", StringParameter, nil)}, invalid: true},
+		{name: "name with leading digit", params: []api.RuntimeParameter{param("3VERSION", StringParameter, nil)}, invalid: true},
+		{name: "base image name", params: []api.RuntimeParameter{param(BaseImageArg, StringParameter, nil)}, invalid: true},
+		{
+			name:    "duplicate",
+			params:  []api.RuntimeParameter{param("WORKERS", IntegerParameter, nil), param("WORKERS", StringParameter, nil)},
+			invalid: true,
+		},
+		{name: "unknown kind", params: []api.RuntimeParameter{param("WORKERS", "float", nil)}, invalid: true},
+		{name: "value of other kind", params: []api.RuntimeParameter{param("WORKERS", IntegerParameter, nil, "1", "many")}, invalid: true},
+		{name: "default of other kind", params: []api.RuntimeParameter{param("DEBUG", BooleanParameter, str("yes"))}, invalid: true},
+		{name: "default out of values", params: []api.RuntimeParameter{param("VERSION", StringParameter, str("2.7"), "3.10", "3.11")}, invalid: true},
+	}
+
+	for _, test := range tests {
+		err := ValidateRuntimeParameters(test.params)
+		if test.invalid && err == nil {
+			t.Errorf("%s: expected error", test.name)
+		} else if !test.invalid && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+	}
+}
+
+func TestResolveBuildArgs(t *testing.T) {
+	params := []api.RuntimeParameter{
+		param("PYTHON_VERSION", StringParameter, str("3.11"), "3.10", "3.11"),
+		param("WORKERS", IntegerParameter, str("2")),
+		param("DEBUG", BooleanParameter, nil),
+	}
+
+	tests := []struct {
+		name     string
+		args     []api.LambdaBuildArg
+		params   []api.RuntimeParameter
+		expected map[string]string
+		invalid  bool
+	}{
+		{
+			name:     "defaults",
+			args:     []api.LambdaBuildArg{{Name: "DEBUG", Value: "false"}},
+			params:   params,
+			expected: map[string]string{"PYTHON_VERSION": "3.11", "WORKERS": "2", "DEBUG": "false"},
+		},
+		{
+			name: "all set",
+			args: []api.LambdaBuildArg{
+				{Name: "WORKERS", Value: "8"},
+				{Name: "PYTHON_VERSION", Value: "3.10"},
+				{Name: "DEBUG", Value: "true"},
+			},
+			params:   params,
+			expected: map[string]string{"PYTHON_VERSION": "3.10", "WORKERS": "8", "DEBUG": "true"},
+		},
+		{
+			name:     "no parameters",
+			params:   nil,
+			expected: map[string]string{},
+		},
+		{
+			name:    "required omitted",
+			args:    []api.LambdaBuildArg{{Name: "WORKERS", Value: "8"}},
+			params:  params,
+			invalid: true,
+		},
+		{
+			name:    "unknown",
+			args:    []api.LambdaBuildArg{{Name: "DEBUG", Value: "true"}, {Name: "THREADS", Value: "4"}},
+			params:  params,
+			invalid: true,
+		},
+		{
+			name:    "unknown without parameters",
+			args:    []api.LambdaBuildArg{{Name: "DEBUG", Value: "true"}},
+			params:  nil,
+			invalid: true,
+		},
+		{
+			name:    "base image",
+			args:    []api.LambdaBuildArg{{Name: "DEBUG", Value: "true"}, {Name: BaseImageArg, Value: "alpine"}},
+			params:  params,
+			invalid: true,
+		},
+		{
+			name:    "duplicate",
+			args:    []api.LambdaBuildArg{{Name: "DEBUG", Value: "true"}, {Name: "DEBUG", Value: "false"}},
+			params:  params,
+			invalid: true,
+		},
+		{
+			name:    "integer of other kind",
+			args:    []api.LambdaBuildArg{{Name: "DEBUG", Value: "true"}, {Name: "WORKERS", Value: "2.5"}},
+			params:  params,
+			invalid: true,
+		},
+		{
+			name:    "boolean of other kind",
+			args:    []api.LambdaBuildArg{{Name: "DEBUG", Value: "yes"}},
+			params:  params,
+			invalid: true,
+		},
+		{
+			name:    "value out of values",
+			args:    []api.LambdaBuildArg{{Name: "DEBUG", Value: "true"}, {Name: "PYTHON_VERSION", Value: "2.7"}},
+			params:  params,
+			invalid: true,
+		},
+	}
+
+	for _, test := range tests {
+		values, err := ResolveBuildArgs(test.args, test.params)
+		if test.invalid {
+			if err == nil {
+				t.Errorf("%s: expected error, got %v", test.name, values)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		} else if !reflect.DeepEqual(values, test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, values)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package model
+
+import (
+	"fmt"
+
+	api "github.com/hedlx/doless/client"
+	"github.com/hedlx/doless/manager/util"
+)
+
+// Platform-wide lambda resource defaults and maxima, so a single lambda can't starve the host.
+var (
+	DefaultMemory    = int64(util.GetIntVarDefault("LAMBDA_DEFAULT_MEMORY", 256*1024*1024))
+	MaxMemory        = int64(util.GetIntVarDefault("LAMBDA_MAX_MEMORY", 2*1024*1024*1024))
+	MaxMemorySwap    = int64(util.GetIntVarDefault("LAMBDA_MAX_MEMORY_SWAP", 4*1024*1024*1024))
+	DefaultCPUQuota  = int64(util.GetIntVarDefault("LAMBDA_DEFAULT_CPU_QUOTA", 50000))
+	MaxCPUQuota      = int64(util.GetIntVarDefault("LAMBDA_MAX_CPU_QUOTA", 200000))
+	DefaultPidsLimit = int64(util.GetIntVarDefault("LAMBDA_DEFAULT_PIDS_LIMIT", 128))
+	MaxPidsLimit     = int64(util.GetIntVarDefault("LAMBDA_MAX_PIDS_LIMIT", 1024))
+	MaxUlimit        = int64(util.GetIntVarDefault("LAMBDA_MAX_ULIMIT", 65536))
+	MaxReplicas      = int64(util.GetIntVarDefault("LAMBDA_MAX_REPLICAS", 10))
+)
+
+var ulimitNames = map[string]bool{
+	"core":       true,
+	"cpu":        true,
+	"fsize":      true,
+	"locks":      true,
+	"nofile":     true,
+	"nproc":      true,
+	"stack":      true,
+	"sigpending": true,
+}
+
+func ValidateReplicas(replicas int64) error {
+	if replicas < 1 || replicas > MaxReplicas {
+		return fmt.Errorf("'replicas' must be between %d and %d", 1, MaxReplicas)
+	}
+
+	return nil
+}
+
+func ValidateResources(res *api.LambdaResources) error {
+	if res.Memory != nil && (*res.Memory < 6*1024*1024 || *res.Memory > MaxMemory) {
+		return fmt.Errorf("'memory' must be between %d and %d", 6*1024*1024, MaxMemory)
+	}
+
+	if res.MemorySwap != nil {
+		memory := DefaultMemory
+		if res.Memory != nil {
+			memory = *res.Memory
+		}
+
+		if *res.MemorySwap < memory || *res.MemorySwap > MaxMemorySwap {
+			return fmt.Errorf("'memory_swap' must be between 'memory' and %d", MaxMemorySwap)
+		}
+	}
+
+	if res.CpuQuota != nil && (*res.CpuQuota < 1000 || *res.CpuQuota > MaxCPUQuota) {
+		return fmt.Errorf("'cpu_quota' must be between %d and %d", 1000, MaxCPUQuota)
+	}
+
+	if res.PidsLimit != nil && (*res.PidsLimit < 1 || *res.PidsLimit > MaxPidsLimit) {
+		return fmt.Errorf("'pids_limit' must be between %d and %d", 1, MaxPidsLimit)
+	}
+
+	names := map[string]bool{}
+	for _, ulimit := range res.Ulimits {
+		if !ulimitNames[ulimit.Name] {
+			return fmt.Errorf("unsupported ulimit: %s", ulimit.Name)
+		}
+
+		if names[ulimit.Name] {
+			return fmt.Errorf("ulimit is duplicated: %s", ulimit.Name)
+		}
+
+		names[ulimit.Name] = true
+
+		if ulimit.Soft < 0 || ulimit.Soft > ulimit.Hard || ulimit.Hard > MaxUlimit {
+			return fmt.Errorf("ulimit '%s' must satisfy 0 <= soft <= hard <= %d", ulimit.Name, MaxUlimit)
+		}
+	}
+
+	return nil
+}
+
+// WithDefaultResources fills missing limits with platform defaults.
+// Swap is disabled unless requested explicitly.
+func WithDefaultResources(res *api.LambdaResources) api.LambdaResources {
+	out := api.LambdaResources{}
+	if res != nil {
+		out = *res
+	}
+
+	if out.Memory == nil {
+		memory := DefaultMemory
+		out.Memory = &memory
+	}
+
+	if out.MemorySwap == nil {
+		out.MemorySwap = out.Memory
+	}
+
+	if out.CpuQuota == nil {
+		quota := DefaultCPUQuota
+		out.CpuQuota = &quota
+	}
+
+	if out.PidsLimit == nil {
+		pids := DefaultPidsLimit
+		out.PidsLimit = &pids
+	}
+
+	return out
+}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"fmt"
+
+	api "github.com/hedlx/doless/client"
+	"github.com/hedlx/doless/manager/util"
+)
+
+const (
+	RestartNever     = "never"
+	RestartOnFailure = "on-failure"
+	RestartAlways    = "always"
+)
+
+var DefaultMaxRetries = int64(util.GetIntVarDefault("LAMBDA_DEFAULT_MAX_RETRIES", 10))
+
+func ValidateRestartPolicy(policy *api.LambdaRestartPolicy) error {
+	if policy.Mode != RestartNever && policy.Mode != RestartOnFailure && policy.Mode != RestartAlways {
+		return fmt.Errorf("'mode' must be one of: %s, %s, %s", RestartNever, RestartOnFailure, RestartAlways)
+	}
+
+	if policy.MaxRetries != nil && *policy.MaxRetries < 0 {
+		return fmt.Errorf("'max_retries' must not be negative")
+	}
+
+	return nil
+}
+
+// WithDefaultRestartPolicy returns restart policy of lambdas without one: on-failure with default max retries.
+func WithDefaultRestartPolicy(policy *api.LambdaRestartPolicy) api.LambdaRestartPolicy {
+	if policy == nil {
+		return api.LambdaRestartPolicy{Mode: RestartOnFailure, MaxRetries: &DefaultMaxRetries}
+	}
+
+	res := *policy
+	if res.Mode == RestartOnFailure && res.MaxRetries == nil {
+		res.MaxRetries = &DefaultMaxRetries
+	}
+
+	return res
+}
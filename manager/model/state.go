@@ -0,0 +1,63 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/samber/lo"
+)
+
+// Lifecycle states of the lambda.
+const (
+	PendingState     = "PENDING"
+	BuildingState    = "BUILDING"
+	BuildFailedState = "BUILD_FAILED"
+	StartingState    = "STARTING"
+	HealthyState     = "HEALTHY"
+	UnhealthyState   = "UNHEALTHY"
+	StoppedState     = "STOPPED"
+	IdleState        = "IDLE"
+	CrashedState     = "CRASHED"
+	DestroyingState  = "DESTROYING"
+)
+
+// RunningStates are states of the lambda whose containers are supposed to run.
+// They follow replica statuses, while other states are changed by lambda operations only.
+var RunningStates = []string{StartingState, HealthyState, UnhealthyState, CrashedState}
+
+// StoppedStates are states of the lambda whose containers are kept stopped. Idle lambda is woken up by the handler on the next request.
+var StoppedStates = []string{StoppedState, IdleState}
+
+// runningTransitions are shared by running states: they follow replica statuses, are rebuilt, rolled out, recreated or restarted,
+// stopped, fall back to pending once recreation fails, and are destroyed.
+var runningTransitions = []string{
+	StartingState, HealthyState, UnhealthyState, CrashedState,
+	BuildingState, StoppedState, IdleState, PendingState, DestroyingState,
+}
+
+// transitions lists states every state may be changed to.
+var transitions = map[string][]string{
+	// Created lambda is built on the first start
+	PendingState: {BuildingState, DestroyingState},
+	// Build either fails, or starts the containers, or is interrupted leaving the lambda undeployed
+	BuildingState:    {BuildFailedState, StartingState, PendingState},
+	BuildFailedState: {BuildingState, DestroyingState},
+	StartingState:    runningTransitions,
+	HealthyState:     runningTransitions,
+	UnhealthyState:   runningTransitions,
+	CrashedState:     runningTransitions,
+	// Stopped lambda is started from the kept containers or rebuilt, and falls back to pending once recreation fails
+	StoppedState: {StartingState, BuildingState, PendingState, DestroyingState},
+	IdleState:    {StartingState, BuildingState, StoppedState, PendingState, DestroyingState},
+	// Destroyed lambda is pending, failed destruction leaves it with what is left
+	DestroyingState: {PendingState, StartingState, StoppedState},
+}
+
+// ValidateTransition refuses changing the lambda state to the one which doesn't follow it in the lifecycle.
+// Staying in the same state is always allowed.
+func ValidateTransition(from string, to string) error {
+	if from != to && !lo.Contains(transitions[from], to) {
+		return fmt.Errorf("lambda can't become %s being %s", to, from)
+	}
+
+	return nil
+}
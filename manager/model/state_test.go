@@ -0,0 +1,106 @@
+package model
+
+import "testing"
+
+var states = []string{
+	PendingState, BuildingState, BuildFailedState, StartingState, HealthyState,
+	UnhealthyState, StoppedState, IdleState, CrashedState, DestroyingState,
+}
+
+func TestValidateTransition(t *testing.T) {
+	tests := []struct {
+		from    string
+		to      string
+		allowed bool
+	}{
+		// Lifecycle of the lambda
+		{PendingState, BuildingState, true},
+		{BuildingState, BuildFailedState, true},
+		{BuildingState, StartingState, true},
+		{BuildingState, PendingState, true},
+		{BuildFailedState, BuildingState, true},
+		{StartingState, HealthyState, true},
+		{HealthyState, UnhealthyState, true},
+		{UnhealthyState, CrashedState, true},
+		{CrashedState, HealthyState, true},
+		{HealthyState, BuildingState, true},
+		{HealthyState, StoppedState, true},
+		{HealthyState, IdleState, true},
+		{CrashedState, PendingState, true},
+		{StoppedState, StartingState, true},
+		{StoppedState, BuildingState, true},
+		{IdleState, StartingState, true},
+		{IdleState, StoppedState, true},
+		{StartingState, IdleState, true},
+		{HealthyState, DestroyingState, true},
+		{StoppedState, DestroyingState, true},
+		{BuildFailedState, DestroyingState, true},
+		{DestroyingState, PendingState, true},
+		{DestroyingState, StoppedState, true},
+		// Containers which are left running by failed destruction
+		{DestroyingState, StartingState, true},
+
+		// Only running containers report health
+		{BuildingState, HealthyState, false},
+		{BuildingState, UnhealthyState, false},
+		{BuildingState, CrashedState, false},
+		{PendingState, HealthyState, false},
+		{StoppedState, HealthyState, false},
+		{IdleState, CrashedState, false},
+		// Lambda is built before it is started
+		{PendingState, StartingState, false},
+		{BuildFailedState, StartingState, false},
+		// Build and destruction are finished before anything else
+		{BuildingState, StoppedState, false},
+		{BuildingState, DestroyingState, false},
+		{DestroyingState, HealthyState, false},
+		{DestroyingState, BuildingState, false},
+		{DestroyingState, IdleState, false},
+		// Lambda is stopped or idle only once it runs
+		{PendingState, StoppedState, false},
+		{PendingState, IdleState, false},
+		{BuildFailedState, StoppedState, false},
+		{StoppedState, IdleState, false},
+		{BuildFailedState, PendingState, false},
+		// Unknown states
+		{"RUNNING", PendingState, false},
+		{PendingState, "RUNNING", false},
+	}
+
+	for _, test := range tests {
+		err := ValidateTransition(test.from, test.to)
+		if test.allowed && err != nil {
+			t.Errorf("%s -> %s: unexpected error: %v", test.from, test.to, err)
+		} else if !test.allowed && err == nil {
+			t.Errorf("%s -> %s: expected error", test.from, test.to)
+		}
+	}
+}
+
+func TestValidateTransitionSameState(t *testing.T) {
+	for _, state := range states {
+		if err := ValidateTransition(state, state); err != nil {
+			t.Errorf("%s: unexpected error: %v", state, err)
+		}
+	}
+}
+
+func TestTransitionsCoverStates(t *testing.T) {
+	known := map[string]bool{}
+	for _, state := range states {
+		known[state] = true
+	}
+
+	for _, state := range states {
+		next, ok := transitions[state]
+		if !ok {
+			t.Errorf("%s: no transitions", state)
+		}
+
+		for _, to := range next {
+			if !known[to] {
+				t.Errorf("%s -> %s: unknown state", state, to)
+			}
+		}
+	}
+}
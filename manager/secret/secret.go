@@ -0,0 +1,112 @@
+// Package secret encrypts secret values of lambda env, which are stored apart from the lambda.
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+
+	api "github.com/hedlx/doless/client"
+)
+
+// Mask replaces secret values of lambda env in the stored lambda model and API responses.
+// Secret passed with the mask keeps its previous value.
+const Mask = "******"
+
+// Key encrypts and decrypts secret values.
+type Key [32]byte
+
+// NewKey derives the key from the master key, so any passphrase can be provided to the manager.
+func NewKey(master string) Key {
+	return sha256.Sum256([]byte(master))
+}
+
+func (k Key) Encrypt(plain string) (string, error) {
+	gcm, err := k.cipher()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	sealed := gcm.Seal(nonce, nonce, []byte(plain), nil)
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (k Key) Decrypt(encrypted string) (string, error) {
+	gcm, err := k.cipher()
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", err
+	}
+
+	if len(sealed) < gcm.NonceSize() {
+		return "", errors.New("malformed secret")
+	}
+
+	nonce, data := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	plain, err := gcm.Open(nil, nonce, data, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return string(plain), nil
+}
+
+func (k Key) cipher() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(k[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// Merge encrypts secret values of the new env and masks them. Secrets passed with the mask keep their previous
+// encrypted values, any other value, including the empty one, replaces the secret.
+// It returns the env to store in the lambda and encrypted secrets by their names.
+func (k Key) Merge(env []api.LambdaEnvVar, prev map[string]string) ([]api.LambdaEnvVar, map[string]string, error) {
+	secrets := map[string]string{}
+	masked := make([]api.LambdaEnvVar, 0, len(env))
+
+	for _, v := range env {
+		if !v.GetSecret() {
+			masked = append(masked, v)
+			continue
+		}
+
+		if v.Value == Mask {
+			encrypted, exists := prev[v.Name]
+			if !exists {
+				return nil, nil, fmt.Errorf("secret value is required: %s", v.Name)
+			}
+
+			secrets[v.Name] = encrypted
+		} else {
+			encrypted, err := k.Encrypt(v.Value)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			secrets[v.Name] = encrypted
+		}
+
+		v.Value = Mask
+		masked = append(masked, v)
+	}
+
+	return masked, secrets, nil
+}
@@ -0,0 +1,149 @@
+package secret
+
+import (
+	"testing"
+
+	api "github.com/hedlx/doless/client"
+)
+
+func secretVar(name string, value string) api.LambdaEnvVar {
+	isSecret := true
+	return api.LambdaEnvVar{Name: name, Value: value, Secret: &isSecret}
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	key := NewKey("master")
+
+	for _, plain := range []string{"value", "", "ключ with spaces\nand lines", Mask} {
+		encrypted, err := key.Encrypt(plain)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", plain, err)
+		}
+
+		if plain != "" && encrypted == plain {
+			t.Errorf("%q: value is not encrypted", plain)
+		}
+
+		decrypted, err := key.Decrypt(encrypted)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", plain, err)
+		}
+
+		if decrypted != plain {
+			t.Errorf("expected %q, got %q", plain, decrypted)
+		}
+	}
+}
+
+func TestEncryptNonce(t *testing.T) {
+	key := NewKey("master")
+
+	first, err := key.Encrypt("value")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := key.Encrypt("value")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if first == second {
+		t.Error("same value is encrypted the same way twice")
+	}
+}
+
+func TestDecryptInvalid(t *testing.T) {
+	key := NewKey("master")
+
+	encrypted, err := key.Encrypt("value")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewKey("other").Decrypt(encrypted); err == nil {
+		t.Error("expected error for another key")
+	}
+
+	tampered := []byte(encrypted)
+	tampered[len(tampered)-3] ^= 1
+	if _, err := key.Decrypt(string(tampered)); err == nil {
+		t.Error("expected error for tampered secret")
+	}
+
+	for _, encrypted := range []string{"", "AAAA", "not base64!"} {
+		if _, err := key.Decrypt(encrypted); err == nil {
+			t.Errorf("%q: expected error", encrypted)
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	key := NewKey("master")
+
+	prevToken, err := key.Encrypt("prev token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	prev := map[string]string{"TOKEN": prevToken, "PASSWORD": "stale"}
+
+	env := []api.LambdaEnvVar{
+		{Name: "MODE", Value: "debug"},
+		secretVar("TOKEN", Mask),
+		secretVar("API_KEY", "new key"),
+		secretVar("PASSWORD", ""),
+	}
+
+	masked, secrets, err := key.Merge(env, prev)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(masked) != len(env) {
+		t.Fatalf("expected %d vars, got %d", len(env), len(masked))
+	}
+
+	if masked[0].Value != "debug" {
+		t.Errorf("plain value is changed: %q", masked[0].Value)
+	}
+
+	for _, v := range masked[1:] {
+		if v.Value != Mask {
+			t.Errorf("%s: secret is not masked: %q", v.Name, v.Value)
+		}
+	}
+
+	if env[2].Value != "new key" {
+		t.Error("passed env is modified")
+	}
+
+	if len(secrets) != 3 {
+		t.Fatalf("expected 3 secrets, got %v", secrets)
+	}
+
+	if secrets["TOKEN"] != prevToken {
+		t.Error("masked secret doesn't keep the previous value")
+	}
+
+	expected := map[string]string{"API_KEY": "new key", "PASSWORD": ""}
+	for name, plain := range expected {
+		decrypted, err := key.Decrypt(secrets[name])
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		if decrypted != plain {
+			t.Errorf("%s: expected %q, got %q", name, plain, decrypted)
+		}
+	}
+}
+
+func TestMergeMaskedWithoutPrevious(t *testing.T) {
+	key := NewKey("master")
+
+	env := []api.LambdaEnvVar{secretVar("TOKEN", Mask)}
+	if _, _, err := key.Merge(env, map[string]string{}); err == nil {
+		t.Error("expected error for masked secret without the previous value")
+	}
+}
@@ -2,102 +2,422 @@ package task
 
 import (
 	"context"
+	"errors"
+	"sort"
 	"sync"
 	"time"
+
+	"github.com/hedlx/doless/manager/db"
+	"github.com/hedlx/doless/manager/logger"
+	"github.com/hedlx/doless/manager/util"
+	"go.uber.org/zap"
 )
 
+// maxProgress limits number of progress lines kept per task.
+const maxProgress = 10000
+
+// saveInterval limits how often progress of a running task is persisted.
+const saveInterval = time.Second
+
+// finishAttempts limits how many times the finished task is tried to be saved before it's dropped.
+const finishAttempts = 5
+
+// finishRetryDelay is the delay before the second save of the finished task, it's doubled for every next one.
+var finishRetryDelay = time.Second
+
+// ttl is how long tasks are kept after their last change.
+var ttl = time.Duration(util.GetIntVarDefault("TASK_TTL", 24*60*60)) * time.Second
+
+// store persists tasks, it's redis outside of tests.
+type store interface {
+	save(ctx context.Context, status Status) error
+	get(ctx context.Context, id string) (*PreparedStatus, error)
+	list(ctx context.Context) ([]*PreparedStatus, error)
+}
+
+type redisStore struct{}
+
+func (redisStore) save(ctx context.Context, status Status) error {
+	return db.SetValueTTL(ctx, "task:"+status.GetInfo().ID, PrepareStatus(status, 0), ttl)
+}
+
+func (redisStore) get(ctx context.Context, id string) (*PreparedStatus, error) {
+	return db.GetValue[PreparedStatus](ctx, "task", id)
+}
+
+func (redisStore) list(ctx context.Context) ([]*PreparedStatus, error) {
+	return db.GetValues[PreparedStatus](ctx, "task")
+}
+
+// service keeps tasks running in this manager in memory and persists all of them in redis.
+// Tasks are saved outside of the lock, so redis doesn't hold up other tasks.
 type service struct {
+	store    store
 	lock     *sync.RWMutex
 	statuses map[string]Status
-	cleanup  map[string]func()
+	cancel   map[string]context.CancelFunc
+	saved    map[string]time.Time
+	changed  map[string]chan struct{}
+	savers   map[string]*saver
+}
+
+// saver orders saves of the task, so a stale snapshot never overwrites a newer one.
+type saver struct {
+	// revision is the last snapshot of the task, it's guarded by the service lock
+	revision int
+	lock     sync.Mutex
+	saved    int
+}
+
+// snapshot is the task status to be saved.
+type snapshot struct {
+	saver    *saver
+	revision int
+	status   Status
+}
+
+// Filter selects tasks by their fields, empty fields match any task.
+type Filter struct {
+	Kind   string
+	Target string
+	Status string
+	Limit  int
 }
 
 type TaskService interface {
-	Add(id string)
+	// Add starts pending task, returned context is cancelled once the task is cancelled.
+	Add(id string, kind string, target string) context.Context
+	Progress(id string, line string)
 	Failed(id string, details interface{})
 	Succeeded(id string, details interface{})
-	Get(id string) Status
+	Get(ctx context.Context, id string) Status
+	List(ctx context.Context, filter Filter) ([]*PreparedStatus, error)
+	Cancel(id string) error
+	// Changed returns channel which is closed on the next change of the task.
+	Changed(id string) <-chan struct{}
+}
+
+func CreateTaskService(ctx context.Context) (TaskService, error) {
+	s := newService(redisStore{})
+
+	if err := s.init(ctx); err != nil {
+		return nil, err
+	}
+
+	return s, nil
 }
 
-func CreateTaskService() TaskService {
+func newService(store store) *service {
 	return &service{
+		store:    store,
 		lock:     &sync.RWMutex{},
 		statuses: map[string]Status{},
-		cleanup:  map[string]func(){},
+		cancel:   map[string]context.CancelFunc{},
+		saved:    map[string]time.Time{},
+		changed:  map[string]chan struct{}{},
+		savers:   map[string]*saver{},
 	}
 }
 
-func (s *service) Add(id string) {
+// init fails tasks which were running when the previous manager stopped.
+func (s *service) init(ctx context.Context) error {
+	tasks, err := s.store.list(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range tasks {
+		if t.Status != PENDING {
+			continue
+		}
+
+		pending := t.restore()
+		failed := Failed{
+			ResultStatus{
+				Info:       pending.GetInfo(),
+				StartedAt_: pending.StartedAt(),
+				FinishedAt: time.Now().UnixMicro(),
+				Details: struct {
+					Error string `json:"error"`
+				}{Error: "task is interrupted by manager restart"},
+				Progress_: pending.Progress(),
+			},
+		}
+
+		if err := s.store.save(ctx, failed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *service) Add(id string, kind string, target string) context.Context {
 	s.lock.Lock()
-	defer s.lock.Unlock()
 
-	s.statuses[id] = Pending{StartedAt_: time.Now().UnixMicro()}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s.statuses[id] = Pending{
+		Info:       Info{ID: id, Kind: kind, Target: target},
+		StartedAt_: time.Now().UnixMicro(),
+	}
+	s.cancel[id] = cancel
+	s.savers[id] = &saver{}
+	snap := s.snapshot(id, true)
+
+	s.lock.Unlock()
+
+	s.persist(id, snap)
+
+	return ctx
+}
+
+func (s *service) Progress(id string, line string) {
+	s.lock.Lock()
+
+	pending, ok := s.statuses[id].(Pending)
+	if !ok || len(pending.Progress_) >= maxProgress {
+		s.lock.Unlock()
+		return
+	}
+
+	if len(pending.Progress_) == maxProgress-1 {
+		line = "... output is truncated"
+	}
+
+	pending.Progress_ = append(pending.Progress_, line)
+	s.statuses[id] = pending
+
+	snap := s.snapshot(id, false)
+	s.notify(id)
+
+	s.lock.Unlock()
+
+	s.persist(id, snap)
 }
 
+// Failed finishes the task, task which was cancelled is considered cancelled instead.
 func (s *service) Failed(id string, details interface{}) {
 	s.lock.Lock()
-	defer s.lock.Unlock()
 
-	s.statuses[id] = Failed{
-		ResultStatus{
-			StartedAt_: s.statuses[id].StartedAt(),
-			FinishedAt: time.Now().UnixMicro(),
-			Details:    details,
-		},
+	result := s.result(id, details)
+	if s.cancel[id] == nil {
+		s.statuses[id] = Cancelled{result}
+	} else {
+		s.statuses[id] = Failed{result}
 	}
 
-	s.poke(id)
+	s.finish(id)
 }
 
 func (s *service) Succeeded(id string, details interface{}) {
 	s.lock.Lock()
-	defer s.lock.Unlock()
 
-	s.statuses[id] = Succeeded{
-		ResultStatus: ResultStatus{
-			StartedAt_: s.statuses[id].StartedAt(),
-			FinishedAt: time.Now().UnixMicro(),
-			Details:    details,
-		},
-	}
+	s.statuses[id] = Succeeded{s.result(id, details)}
+
+	s.finish(id)
+}
+
+func (s *service) result(id string, details interface{}) ResultStatus {
+	status := s.statuses[id]
 
-	s.poke(id)
+	return ResultStatus{
+		Info:       status.GetInfo(),
+		StartedAt_: status.StartedAt(),
+		FinishedAt: time.Now().UnixMicro(),
+		Details:    details,
+		Progress_:  status.Progress(),
+	}
 }
 
-func (s *service) Get(id string) Status {
+// finish persists finished task and forgets it, so it is served from redis since then.
+// Failed saves are retried with backoff, task is forgotten anyway once attempts are exhausted.
+// It's called with the lock held and releases it.
+func (s *service) finish(id string) {
+	if cancel := s.cancel[id]; cancel != nil {
+		cancel()
+	}
+
+	delete(s.cancel, id)
+
+	snap := s.snapshot(id, true)
+	s.notify(id)
+
+	s.lock.Unlock()
+
+	// Task is kept in memory and served from there until it's saved
+	delay := finishRetryDelay
+	for attempt := 1; !s.persist(id, snap); attempt++ {
+		if attempt == finishAttempts {
+			logger.L.Error(
+				"Failed to save finished task, it's dropped",
+				zap.String("id", id),
+				zap.Int("attempts", attempt),
+			)
+			break
+		}
+
+		time.Sleep(delay)
+		delay *= 2
+	}
+
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
+	delete(s.statuses, id)
+	delete(s.saved, id)
+	delete(s.savers, id)
+}
+
+// snapshot returns the task status to persist unless it has been saved recently, it's called with the lock held.
+func (s *service) snapshot(id string, force bool) *snapshot {
+	if !force && time.Since(s.saved[id]) < saveInterval {
+		return nil
+	}
+
+	sv := s.savers[id]
+	sv.revision++
+	s.saved[id] = time.Now()
+
+	return &snapshot{saver: sv, revision: sv.revision, status: s.statuses[id]}
+}
+
+// persist saves the snapshot unless a newer one is already saved.
+func (s *service) persist(id string, snap *snapshot) bool {
+	if snap == nil {
+		return false
+	}
+
+	snap.saver.lock.Lock()
+	defer snap.saver.lock.Unlock()
+
+	if snap.revision <= snap.saver.saved {
+		return true
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := s.store.save(ctx, snap.status); err != nil {
+		logger.L.Error(
+			"Failed to save task",
+			zap.Error(err),
+			zap.String("id", id),
+		)
+
+		// Throttled progress is saved on the next change then
+		return false
+	}
+
+	snap.saver.saved = snap.revision
+
+	return true
+}
+
+func (s *service) Get(ctx context.Context, id string) Status {
+	s.lock.RLock()
 	status, ok := s.statuses[id]
+	s.lock.RUnlock()
 
-	if !ok {
+	if ok {
+		return status
+	}
+
+	stored, err := s.store.get(ctx, id)
+	if err != nil || stored == nil {
 		return nil
 	}
 
-	s.poke(id)
-	return status
+	return stored.restore()
 }
 
-func (s *service) poke(id string) {
-	cancel := s.cleanup[id]
-	if cancel != nil {
-		cancel()
+// List returns tasks matching the filter without their progress, the latest first.
+func (s *service) List(ctx context.Context, filter Filter) ([]*PreparedStatus, error) {
+	stored, err := s.store.list(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
+	tasks := map[string]*PreparedStatus{}
+	for _, t := range stored {
+		// Restored status is sent the same way as the running one, whichever version stored it
+		tasks[t.ID] = PrepareStatus(t.restore(), 0)
+	}
 
-	go func() {
-		select {
-		case <-time.After(15 * time.Minute):
-			s.lock.Lock()
-			defer s.lock.Unlock()
+	s.lock.RLock()
+	for id, status := range s.statuses {
+		// Running tasks are fresher than their throttled copies
+		tasks[id] = PrepareStatus(status, 0)
+	}
+	s.lock.RUnlock()
 
-			delete(s.cleanup, id)
-			delete(s.statuses, id)
-		case <-ctx.Done():
-			return
+	res := []*PreparedStatus{}
+	for _, t := range tasks {
+		if (filter.Kind == "" || t.Kind == filter.Kind) &&
+			(filter.Target == "" || t.Target == filter.Target) &&
+			(filter.Status == "" || t.Status == filter.Status) {
+			res = append(res, t)
 		}
-	}()
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].StartedAt_ > res[j].StartedAt_ })
+
+	if filter.Limit > 0 && len(res) > filter.Limit {
+		res = res[:filter.Limit]
+	}
+
+	// Progress may be thousands of lines per task, it's served by the task itself
+	for _, t := range res {
+		t.Progress = nil
+	}
+
+	return res, nil
+}
+
+// Cancel cancels context of the running task. Task becomes cancelled once its work is stopped.
+func (s *service) Cancel(id string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	status, ok := s.statuses[id]
+	if !ok || !status.Pending() {
+		return errors.New("task is not running")
+	}
 
-	s.cleanup[id] = cancel
+	cancel := s.cancel[id]
+	if cancel == nil {
+		return errors.New("task is already being cancelled")
+	}
+
+	cancel()
+	delete(s.cancel, id)
+
+	return nil
+}
+
+func (s *service) Changed(id string) <-chan struct{} {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.statuses[id]; !ok {
+		// Finished tasks don't change anymore
+		closed := make(chan struct{})
+		close(closed)
+		return closed
+	}
+
+	changed, ok := s.changed[id]
+	if !ok {
+		changed = make(chan struct{})
+		s.changed[id] = changed
+	}
+
+	return changed
+}
+
+func (s *service) notify(id string) {
+	if changed, ok := s.changed[id]; ok {
+		close(changed)
+		delete(s.changed, id)
+	}
 }
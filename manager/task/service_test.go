@@ -0,0 +1,259 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memoryStore keeps saved tasks in memory, saves fail until their number exceeds failures.
+type memoryStore struct {
+	lock     sync.Mutex
+	tasks    map[string]*PreparedStatus
+	saves    int
+	failures int
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{tasks: map[string]*PreparedStatus{}}
+}
+
+func (m *memoryStore) save(_ context.Context, status Status) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.saves++
+	if m.saves <= m.failures {
+		return errors.New("store is unavailable")
+	}
+
+	m.tasks[status.GetInfo().ID] = PrepareStatus(status, 0)
+	return nil
+}
+
+func (m *memoryStore) get(_ context.Context, id string) (*PreparedStatus, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.tasks[id], nil
+}
+
+func (m *memoryStore) list(_ context.Context) ([]*PreparedStatus, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	tasks := []*PreparedStatus{}
+	for _, t := range m.tasks {
+		tasks = append(tasks, t)
+	}
+
+	return tasks, nil
+}
+
+func (m *memoryStore) status(id string) string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if t, ok := m.tasks[id]; ok {
+		return t.Status
+	}
+
+	return ""
+}
+
+func TestPersistSkipsStaleRevision(t *testing.T) {
+	store := newMemoryStore()
+	s := newService(store)
+	s.Add("task", "lambda.start", "lambda")
+
+	s.lock.Lock()
+	stale := s.snapshot("task", true)
+	s.statuses["task"] = Succeeded{s.result("task", nil)}
+	fresh := s.snapshot("task", true)
+	s.lock.Unlock()
+
+	if !s.persist("task", fresh) {
+		t.Fatal("fresh snapshot is not saved")
+	}
+
+	// Stale snapshot is considered saved, as a newer one is already there
+	if !s.persist("task", stale) {
+		t.Fatal("stale snapshot is reported as failed")
+	}
+
+	if status := store.status("task"); status != SUCCEEDED {
+		t.Errorf("stale snapshot overwrote the fresh one: %s", status)
+	}
+}
+
+func TestProgressIsThrottled(t *testing.T) {
+	store := newMemoryStore()
+	s := newService(store)
+	s.Add("task", "lambda.start", "lambda")
+
+	for _, line := range []string{"Step 1/2", "Step 2/2"} {
+		s.Progress("task", line)
+	}
+
+	if saves := store.saves; saves != 1 {
+		t.Errorf("expected only the added task to be saved, got %d saves", saves)
+	}
+
+	if progress := s.Get(context.Background(), "task").Progress(); len(progress) != 2 {
+		t.Errorf("expected running task to keep all progress, got %v", progress)
+	}
+
+	s.Succeeded("task", nil)
+
+	stored := store.tasks["task"]
+	if stored.Status != SUCCEEDED || len(stored.Progress) != 2 {
+		t.Errorf("finished task is saved without its progress: %+v", stored)
+	}
+}
+
+func TestFinishRetriesSave(t *testing.T) {
+	prevDelay := finishRetryDelay
+	finishRetryDelay = time.Millisecond
+	t.Cleanup(func() { finishRetryDelay = prevDelay })
+
+	store := newMemoryStore()
+	s := newService(store)
+	s.Add("task", "lambda.start", "lambda")
+
+	store.failures = store.saves + finishAttempts - 1
+	s.Succeeded("task", nil)
+
+	if status := store.status("task"); status != SUCCEEDED {
+		t.Errorf("finished task is not saved by the last attempt: %s", status)
+	}
+
+	if _, running := s.statuses["task"]; running {
+		t.Error("saved task is kept in memory")
+	}
+}
+
+func TestFinishDropsUnsavedTask(t *testing.T) {
+	prevDelay := finishRetryDelay
+	finishRetryDelay = time.Millisecond
+	t.Cleanup(func() { finishRetryDelay = prevDelay })
+
+	store := newMemoryStore()
+	s := newService(store)
+	s.Add("task", "lambda.start", "lambda")
+
+	store.failures = store.saves + finishAttempts
+	s.Failed("task", nil)
+
+	if saves := store.saves - 1; saves != finishAttempts {
+		t.Errorf("expected %d attempts, got %d", finishAttempts, saves)
+	}
+
+	if _, running := s.statuses["task"]; running {
+		t.Error("unsaved task is kept in memory after attempts are exhausted")
+	}
+}
+
+func TestCancel(t *testing.T) {
+	store := newMemoryStore()
+	s := newService(store)
+	ctx := s.Add("task", "lambda.start", "lambda")
+
+	if err := s.Cancel("task"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	select {
+	case <-ctx.Done():
+	default:
+		t.Fatal("task context is not cancelled")
+	}
+
+	if err := s.Cancel("task"); err == nil {
+		t.Error("expected error for the task which is being cancelled")
+	}
+
+	// Work of the task fails once its context is cancelled
+	s.Failed("task", nil)
+
+	if status := store.status("task"); status != CANCELLED {
+		t.Errorf("expected cancelled task, got %s", status)
+	}
+
+	if err := s.Cancel("task"); err == nil {
+		t.Error("expected error for the finished task")
+	}
+
+	if err := s.Cancel("missing"); err == nil {
+		t.Error("expected error for the unknown task")
+	}
+}
+
+func TestFailedWithoutCancel(t *testing.T) {
+	store := newMemoryStore()
+	s := newService(store)
+	s.Add("task", "lambda.start", "lambda")
+
+	s.Failed("task", nil)
+
+	if status := store.status("task"); status != FAILED {
+		t.Errorf("expected failed task, got %s", status)
+	}
+}
+
+func TestChanged(t *testing.T) {
+	s := newService(newMemoryStore())
+	s.Add("task", "lambda.start", "lambda")
+
+	changed := s.Changed("task")
+	select {
+	case <-changed:
+		t.Fatal("channel is closed before the task is changed")
+	default:
+	}
+
+	s.Progress("task", "Step 1/2")
+
+	select {
+	case <-changed:
+	default:
+		t.Fatal("channel is not closed on progress")
+	}
+
+	s.Succeeded("task", nil)
+
+	select {
+	case <-s.Changed("task"):
+	default:
+		t.Fatal("channel of the finished task is not closed")
+	}
+}
+
+func TestInitFailsInterruptedTasks(t *testing.T) {
+	store := newMemoryStore()
+	store.tasks["running"] = &PreparedStatus{ID: "running", Status: PENDING, Progress: []string{"Step 1/2"}}
+	store.tasks["done"] = &PreparedStatus{ID: "done", Status: legacySucceeded}
+
+	s := newService(store)
+	if err := s.init(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if status := store.status("running"); status != FAILED {
+		t.Errorf("expected interrupted task to fail, got %s", status)
+	}
+
+	if progress := store.tasks["running"].Progress; len(progress) != 1 {
+		t.Errorf("interrupted task lost its progress: %v", progress)
+	}
+
+	tasks, err := s.List(context.Background(), Filter{Status: SUCCEEDED})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(tasks) != 1 || tasks[0].ID != "done" {
+		t.Errorf("expected task stored with legacy status to be succeeded, got %v", tasks)
+	}
+}
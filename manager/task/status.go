@@ -1,79 +1,159 @@
 package task
 
 const (
-	PENDING  = "PENDING"
-	SUCCEDED = "SUCCEDED"
-	FAILED   = "FAILED"
+	PENDING   = "PENDING"
+	SUCCEEDED = "SUCCEEDED"
+	FAILED    = "FAILED"
+	CANCELLED = "CANCELLED"
 )
 
+// legacySucceeded is the misspelled status of the succeeded tasks stored by the previous versions.
+const legacySucceeded = "SUCCEDED"
+
 type Status interface {
 	Pending() bool
 	Failed() bool
 	Succeeded() bool
 	StartedAt() int64
+	Progress() []string
+	GetInfo() Info
+}
+
+// Info describes what the task does.
+type Info struct {
+	ID     string `json:"id"`
+	Kind   string `json:"kind"`
+	Target string `json:"target"`
 }
 
+func (i Info) GetInfo() Info { return i }
+
 type Pending struct {
-	StartedAt_ int64 `json:"started_at"`
+	Info
+	StartedAt_ int64    `json:"started_at"`
+	Progress_  []string `json:"progress,omitempty"`
 }
 
-func (s Pending) Pending() bool    { return true }
-func (s Pending) Failed() bool     { return false }
-func (s Pending) Succeeded() bool  { return false }
-func (s Pending) StartedAt() int64 { return s.StartedAt_ }
+func (s Pending) Pending() bool      { return true }
+func (s Pending) Failed() bool       { return false }
+func (s Pending) Succeeded() bool    { return false }
+func (s Pending) StartedAt() int64   { return s.StartedAt_ }
+func (s Pending) Progress() []string { return s.Progress_ }
 
 type ResultStatus struct {
+	Info
 	StartedAt_ int64       `json:"started_at"`
 	FinishedAt int64       `json:"finished_at"`
 	Details    interface{} `json:"details"`
+	Progress_  []string    `json:"progress,omitempty"`
 }
 type Failed struct {
 	ResultStatus
 }
 
-func (s Failed) Pending() bool    { return false }
-func (s Failed) Failed() bool     { return true }
-func (s Failed) Succeeded() bool  { return false }
-func (s Failed) StartedAt() int64 { return s.StartedAt_ }
+func (s Failed) Pending() bool      { return false }
+func (s Failed) Failed() bool       { return true }
+func (s Failed) Succeeded() bool    { return false }
+func (s Failed) StartedAt() int64   { return s.StartedAt_ }
+func (s Failed) Progress() []string { return s.Progress_ }
 
 type Succeeded struct {
 	ResultStatus
 }
 
-func (s Succeeded) Pending() bool    { return false }
-func (s Succeeded) Failed() bool     { return false }
-func (s Succeeded) Succeeded() bool  { return true }
-func (s Succeeded) StartedAt() int64 { return s.StartedAt_ }
+func (s Succeeded) Pending() bool      { return false }
+func (s Succeeded) Failed() bool       { return false }
+func (s Succeeded) Succeeded() bool    { return true }
+func (s Succeeded) StartedAt() int64   { return s.StartedAt_ }
+func (s Succeeded) Progress() []string { return s.Progress_ }
+
+// Cancelled is the status of the task stopped on request before it finished.
+type Cancelled struct {
+	ResultStatus
+}
+
+func (s Cancelled) Pending() bool      { return false }
+func (s Cancelled) Failed() bool       { return false }
+func (s Cancelled) Succeeded() bool    { return false }
+func (s Cancelled) StartedAt() int64   { return s.StartedAt_ }
+func (s Cancelled) Progress() []string { return s.Progress_ }
 
 type PreparedStatus struct {
+	ID         string      `json:"id"`
+	Kind       string      `json:"kind"`
+	Target     string      `json:"target"`
 	Status     string      `json:"status"`
 	StartedAt_ int64       `json:"started_at"`
 	FinishedAt *int64      `json:"finished_at,omitempty"`
 	Details    interface{} `json:"details,omitempty"`
+	Progress   []string    `json:"progress,omitempty"`
 }
 
-func PrepareStatus(status Status) *PreparedStatus {
+// PrepareStatus converts status to the API model, progress lines before offset are omitted.
+func PrepareStatus(status Status, offset int) *PreparedStatus {
+	progress := status.Progress()
+	if offset >= len(progress) {
+		progress = nil
+	} else if offset > 0 {
+		progress = progress[offset:]
+	}
+
+	info := status.GetInfo()
+	prepared := &PreparedStatus{
+		ID:         info.ID,
+		Kind:       info.Kind,
+		Target:     info.Target,
+		StartedAt_: status.StartedAt(),
+		Progress:   progress,
+	}
+
 	switch ts := status.(type) {
 	case Pending:
-		return &PreparedStatus{
-			Status:     PENDING,
-			StartedAt_: ts.StartedAt_,
-		}
+		prepared.Status = PENDING
 	case Failed:
-		return &PreparedStatus{
-			Status:     FAILED,
-			StartedAt_: ts.StartedAt_,
-			FinishedAt: &ts.FinishedAt,
-			Details:    ts.Details,
-		}
+		prepared.Status = FAILED
+		prepared.FinishedAt = &ts.FinishedAt
+		prepared.Details = ts.Details
 	case Succeeded:
-		return &PreparedStatus{
-			Status:     SUCCEDED,
-			StartedAt_: ts.StartedAt_,
-			FinishedAt: &ts.FinishedAt,
-			Details:    ts.Details,
-		}
+		prepared.Status = SUCCEEDED
+		prepared.FinishedAt = &ts.FinishedAt
+		prepared.Details = ts.Details
+	case Cancelled:
+		prepared.Status = CANCELLED
+		prepared.FinishedAt = &ts.FinishedAt
+		prepared.Details = ts.Details
+	default:
+		return nil
+	}
+
+	return prepared
+}
+
+// restore converts stored status back, so stored and running tasks are handled the same way.
+func (p PreparedStatus) restore() Status {
+	info := Info{ID: p.ID, Kind: p.Kind, Target: p.Target}
+
+	if p.Status == PENDING {
+		return Pending{Info: info, StartedAt_: p.StartedAt_, Progress_: p.Progress}
 	}
 
-	return nil
+	result := ResultStatus{
+		Info:       info,
+		StartedAt_: p.StartedAt_,
+		Details:    p.Details,
+		Progress_:  p.Progress,
+	}
+
+	if p.FinishedAt != nil {
+		result.FinishedAt = *p.FinishedAt
+	}
+
+	switch p.Status {
+	case SUCCEEDED, legacySucceeded:
+		return Succeeded{result}
+	case CANCELLED:
+		return Cancelled{result}
+	default:
+		return Failed{result}
+	}
 }
@@ -27,3 +27,12 @@ func GetIntVar(name string) int {
 
 	return v
 }
+
+// GetIntVarDefault is like GetIntVar, but falls back to def when env var is missing.
+func GetIntVarDefault(name string, def int) int {
+	if os.Getenv(name) == "" {
+		return def
+	}
+
+	return GetIntVar(name)
+}